@@ -0,0 +1,307 @@
+package collection
+
+import "context"
+
+// FromChan creates a new Collection by draining ch until it is closed.
+// @group Channels
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// FromChan blocks until ch is closed. Use FromChanCtx to bound the wait
+// or the number of items received.
+//
+// Example: integers
+//
+//	ch := make(chan int, 3)
+//	ch <- 1
+//	ch <- 2
+//	ch <- 3
+//	close(ch)
+//
+//	c := collection.FromChan(ch)
+//	collection.Dump(c.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func FromChan[T any](ch <-chan T) *Collection[T] {
+	items := []T{}
+	for v := range ch {
+		items = append(items, v)
+	}
+	return New(items)
+}
+
+// FromChanCtx creates a new Collection by receiving from ch until it is
+// closed, limit items have been received, or ctx is done.
+// @group Channels
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// If limit <= 0, there is no item limit. When ctx ends the wait, the items
+// received so far are returned together with ctx.Err(). Reaching the limit
+// or a closed channel returns a nil error; ch is never closed by FromChanCtx.
+//
+// Example: take the first two items
+//
+//	ch := make(chan string, 3)
+//	ch <- "a"
+//	ch <- "b"
+//	ch <- "c"
+//
+//	c, err := collection.FromChanCtx(context.Background(), ch, 2)
+//	collection.Dump(c.Items())
+//	fmt.Println(err)
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	// ]
+//	// <nil>
+func FromChanCtx[T any](ctx context.Context, ch <-chan T, limit int) (*Collection[T], error) {
+	items := []T{}
+	for limit <= 0 || len(items) < limit {
+		// select picks randomly among ready cases, so check ctx first to
+		// stop promptly even while ch still has buffered items.
+		if err := ctx.Err(); err != nil {
+			return New(items), err
+		}
+		select {
+		case <-ctx.Done():
+			return New(items), ctx.Err()
+		case v, ok := <-ch:
+			if !ok {
+				return New(items), nil
+			}
+			items = append(items, v)
+		}
+	}
+	return New(items), nil
+}
+
+// ToChan sends every item into a new channel with the given buffer size and
+// closes it once all items have been sent.
+// @group Channels
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Items are sent from a new goroutine, which exits only after the last item
+// is received. If the consumer may stop early, use ToChanCtx so the
+// goroutine can be released. A buffer < 0 is treated as 0 (unbuffered).
+//
+// Example: integers
+//
+//	for v := range collection.New([]int{1, 2, 3}).ToChan(1) {
+//		fmt.Println(v)
+//	}
+//	// 1
+//	// 2
+//	// 3
+func (c *Collection[T]) ToChan(buffer int) <-chan T {
+	return c.ToChanCtx(context.Background(), buffer)
+}
+
+// ToChanCtx sends every item into a new channel with the given buffer size,
+// stopping early when ctx is done. The channel is always closed.
+// @group Channels
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// A buffer < 0 is treated as 0 (unbuffered).
+//
+// Example: consumer stops early
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	ch := collection.New([]int{1, 2, 3, 4}).ToChanCtx(ctx, 0)
+//	fmt.Println(<-ch)
+//	cancel()
+//	// 1
+func (c *Collection[T]) ToChanCtx(ctx context.Context, buffer int) <-chan T {
+	out := make(chan T, max(buffer, 0))
+	items := c.items
+
+	go func() {
+		defer close(out)
+		for _, v := range items {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- v:
+			}
+		}
+	}()
+
+	return out
+}
+
+// FilterChan streams the values from in for which fn returns true into the
+// returned channel.
+// @group Channels
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// The returned channel is unbuffered and is closed when in is closed or ctx
+// is done. Order is preserved.
+//
+// Example: integers
+//
+//	in := collection.New([]int{1, 2, 3, 4}).ToChan(0)
+//	evens := collection.FilterChan(context.Background(), in, func(v int) bool {
+//		return v%2 == 0
+//	})
+//	collection.Dump(collection.FromChan(evens).Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 4 #int
+//	// ]
+func FilterChan[T any](ctx context.Context, in <-chan T, fn func(T) bool) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				if !fn(v) {
+					continue
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- v:
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// MapToChan streams fn(v) for every value v from in into the returned channel.
+// @group Channels
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// The returned channel is unbuffered and is closed when in is closed or ctx
+// is done. Order is preserved.
+//
+// Example: integers to strings
+//
+//	in := collection.New([]int{1, 2}).ToChan(0)
+//	labels := collection.MapToChan(context.Background(), in, func(v int) string {
+//		return fmt.Sprintf("#%d", v)
+//	})
+//	collection.Dump(collection.FromChan(labels).Items())
+//	// #[]string [
+//	//   0 => "#1" #string
+//	//   1 => "#2" #string
+//	// ]
+func MapToChan[T any, R any](ctx context.Context, in <-chan T, fn func(T) R) <-chan R {
+	out := make(chan R)
+
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- fn(v):
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// ChunkChan groups values from in into slices of the given size and streams
+// each chunk into the returned channel.
+// @group Channels
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// The final chunk may be smaller and is flushed when in is closed. If ctx is
+// done, any partial chunk is dropped. If size <= 0, the returned channel is
+// closed immediately. Each chunk is a freshly allocated slice.
+//
+// Example: integers
+//
+//	in := collection.New([]int{1, 2, 3, 4, 5}).ToChan(0)
+//	chunks := collection.ChunkChan(context.Background(), in, 2)
+//	collection.Dump(collection.FromChan(chunks).Items())
+//	// #[][]int [
+//	//   0 => #[]int [
+//	//     0 => 1 #int
+//	//     1 => 2 #int
+//	//   ]
+//	//   1 => #[]int [
+//	//     0 => 3 #int
+//	//     1 => 4 #int
+//	//   ]
+//	//   2 => #[]int [
+//	//     0 => 5 #int
+//	//   ]
+//	// ]
+func ChunkChan[T any](ctx context.Context, in <-chan T, size int) <-chan []T {
+	out := make(chan []T)
+
+	go func() {
+		defer close(out)
+		if size <= 0 {
+			return
+		}
+
+		send := func(chunk []T) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case out <- chunk:
+				return true
+			}
+		}
+
+		chunk := make([]T, 0, size)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					if len(chunk) > 0 {
+						send(chunk)
+					}
+					return
+				}
+				chunk = append(chunk, v)
+				if len(chunk) < size {
+					continue
+				}
+				if !send(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+	}()
+
+	return out
+}
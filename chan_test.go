@@ -0,0 +1,217 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFromChan_DrainsUntilClosed(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	c := FromChan(ch)
+
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", c.Items())
+	}
+}
+
+func TestFromChan_ClosedEmptyIsNonNil(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+
+	c := FromChan(ch)
+
+	if c.Items() == nil || len(c.Items()) != 0 {
+		t.Fatalf("expected empty non-nil items, got %#v", c.Items())
+	}
+}
+
+func TestFromChanCtx_Limit(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+
+	c, err := FromChanCtx(context.Background(), ch, 3)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", c.Items())
+	}
+	if len(ch) != 2 {
+		t.Fatalf("expected 2 items left in channel, got %d", len(ch))
+	}
+}
+
+func TestFromChanCtx_Timeout(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 7
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	c, err := FromChanCtx(ctx, ch, 0)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if !reflect.DeepEqual(c.Items(), []int{7}) {
+		t.Fatalf("expected partial [7], got %v", c.Items())
+	}
+}
+
+func TestFromChanCtx_CancelledWithBufferedItems(t *testing.T) {
+	ch := make(chan int, 100)
+	for i := 0; i < 100; i++ {
+		ch <- i
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c, err := FromChanCtx(ctx, ch, 0)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(c.Items()) != 0 || len(ch) != 100 {
+		t.Fatalf("expected nothing received, got %v with %d left", c.Items(), len(ch))
+	}
+}
+
+func TestFromChanCtx_ClosedChannel(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+	close(ch)
+
+	c, err := FromChanCtx(context.Background(), ch, 10)
+
+	if err != nil || !reflect.DeepEqual(c.Items(), []int{1}) {
+		t.Fatalf("expected [1] and nil error, got %v, %v", c.Items(), err)
+	}
+}
+
+func TestToChan_SendsAllAndCloses(t *testing.T) {
+	ch := New([]int{1, 2, 3}).ToChan(0)
+
+	var got []int
+	for v := range ch {
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestToChan_Buffering(t *testing.T) {
+	ch := New([]int{1, 2, 3}).ToChan(8)
+
+	if cap(ch) != 8 {
+		t.Fatalf("expected buffer capacity 8, got %d", cap(ch))
+	}
+
+	if cap(New([]int{1}).ToChan(-1)) != 0 {
+		t.Fatalf("expected negative buffer to be treated as unbuffered")
+	}
+}
+
+func TestToChanCtx_CancelClosesChannel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := New([]int{1, 2, 3, 4}).ToChanCtx(ctx, 0)
+
+	<-ch
+	cancel()
+
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-deadline:
+			t.Fatalf("channel was not closed after cancel")
+		}
+	}
+}
+
+func TestFilterChan_PreservesOrder(t *testing.T) {
+	in := New([]int{1, 2, 3, 4, 5, 6}).ToChan(0)
+
+	out := FilterChan(context.Background(), in, func(v int) bool { return v%2 == 0 })
+
+	if got := FromChan(out).Items(); !reflect.DeepEqual(got, []int{2, 4, 6}) {
+		t.Fatalf("expected [2 4 6], got %v", got)
+	}
+}
+
+func TestMapToChan_Transforms(t *testing.T) {
+	in := New([]int{1, 2, 3}).ToChan(0)
+
+	out := MapToChan(context.Background(), in, func(v int) int { return v * v })
+
+	if got := FromChan(out).Items(); !reflect.DeepEqual(got, []int{1, 4, 9}) {
+		t.Fatalf("expected [1 4 9], got %v", got)
+	}
+}
+
+func TestMapToChan_CancelClosesOutput(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+
+	out := MapToChan(ctx, in, func(v int) int { return v })
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatalf("expected no values after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("output channel was not closed after cancel")
+	}
+}
+
+func TestChunkChan_FlushesFinalChunk(t *testing.T) {
+	in := New([]int{1, 2, 3, 4, 5}).ToChan(0)
+
+	out := ChunkChan(context.Background(), in, 2)
+
+	expected := [][]int{{1, 2}, {3, 4}, {5}}
+	if got := FromChan(out).Items(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestChunkChan_NonPositiveSize(t *testing.T) {
+	in := make(chan int)
+
+	out := ChunkChan(context.Background(), in, 0)
+
+	if got := FromChan(out).Items(); len(got) != 0 {
+		t.Fatalf("expected no chunks, got %v", got)
+	}
+}
+
+func TestChannelPipeline_Composes(t *testing.T) {
+	ctx := context.Background()
+	in := New([]int{1, 2, 3, 4, 5, 6, 7}).ToChanCtx(ctx, 2)
+
+	odds := FilterChan(ctx, in, func(v int) bool { return v%2 == 1 })
+	tens := MapToChan(ctx, odds, func(v int) int { return v * 10 })
+	chunks := ChunkChan(ctx, tens, 3)
+
+	expected := [][]int{{10, 30, 50}, {70}}
+	if got := FromChan(chunks).Items(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
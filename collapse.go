@@ -0,0 +1,47 @@
+package collection
+
+// Collapse concatenates a collection of collections into a single collection.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Inner collections are copied in order into a new backing array; nil and
+// empty collections contribute nothing. Use Flatten for a collection of
+// plain slices.
+//
+// Mirrors Laravel's collapse() semantics.
+//
+// This cannot be a method because a method on Collection[*Collection[T]]
+// can't name the element type T of the inner collections.
+//
+// Example: integers
+//
+//	batches := collection.New([]*collection.Collection[int]{
+//		collection.New([]int{1, 2}),
+//		nil,
+//		collection.New([]int{3}),
+//	})
+//	all := collection.Collapse(batches)
+//	collection.Dump(all.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func Collapse[T any](c *Collection[*Collection[T]]) *Collection[T] {
+	n := 0
+	for _, inner := range c.items {
+		if inner != nil {
+			n += len(inner.items)
+		}
+	}
+
+	out := make([]T, 0, n)
+	for _, inner := range c.items {
+		if inner != nil {
+			out = append(out, inner.items...)
+		}
+	}
+	return New(out)
+}
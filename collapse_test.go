@@ -0,0 +1,37 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCollapse_Basic(t *testing.T) {
+	c := New([]*Collection[string]{
+		New([]string{"a"}),
+		nil,
+		New([]string{}),
+		New([]string{"b", "c"}),
+	})
+
+	got := Collapse(c).Items()
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("expected [a b c], got %v", got)
+	}
+}
+
+func TestCollapse_DoesNotAlias(t *testing.T) {
+	inner := New([]int{1, 2})
+	out := Collapse(New([]*Collection[int]{inner}))
+
+	out.Items()[0] = 99
+	if inner.Items()[0] != 1 {
+		t.Fatalf("Collapse should copy into a new backing array")
+	}
+}
+
+func TestCollapse_Empty(t *testing.T) {
+	got := Collapse(New([]*Collection[int]{nil})).Items()
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil items, got %#v", got)
+	}
+}
@@ -0,0 +1,418 @@
+package collection
+
+import (
+	"cmp"
+	"time"
+)
+
+// Comparator is a composable, multi-key ordering for T.
+//
+// Build one with By, ByDesc, ByTime, ByPtr or ByFunc, then chain further
+// keys with Then and ThenDesc. Keys are compared in order; the first key
+// that differs decides. Pass Compare to SortWith, MinWith or MaxWith, or
+// Less to Sort.
+//
+// Comparators are values: every method returns a new Comparator and never
+// modifies the receiver, so partially built comparators can be shared.
+type Comparator[T any] struct {
+	keys []sortKey[T]
+}
+
+type nilPlacement int
+
+const (
+	nilsDefault nilPlacement = iota
+	nilsFirst
+	nilsLast
+)
+
+// sortKey is a single ordering stage within a Comparator.
+type sortKey[T any] struct {
+	compare func(a, b T) int
+	isNil   func(T) bool // nil for keys that cannot be nil
+	desc    bool
+	nils    nilPlacement
+}
+
+func (k sortKey[T]) compareKey(a, b T) int {
+	if k.isNil != nil {
+		an, bn := k.isNil(a), k.isNil(b)
+		if an && bn {
+			return 0
+		}
+		if an || bn {
+			// By default nil sorts as the smallest value.
+			r := 1
+			if an {
+				r = -1
+			}
+			switch k.nils {
+			case nilsFirst:
+				return r
+			case nilsLast:
+				return -r
+			}
+			if k.desc {
+				return -r
+			}
+			return r
+		}
+	}
+
+	r := k.compare(a, b)
+	if k.desc {
+		return -r
+	}
+	return r
+}
+
+// By returns a Comparator ordering T ascending by the key returned from keyFn.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Keys are compared with cmp.Compare, so NaN sorts before other floats.
+//
+// Example: sort by region, then by errors descending
+//
+//	type Event struct {
+//		Region string
+//		Errors int
+//	}
+//
+//	events := collection.New([]Event{
+//		{Region: "us-west", Errors: 3},
+//		{Region: "us-east", Errors: 1},
+//		{Region: "us-west", Errors: 9},
+//		{Region: "us-east", Errors: 7},
+//	})
+//
+//	order := collection.By(func(e Event) string { return e.Region }).
+//		ThenDesc(collection.By(func(e Event) int { return e.Errors }))
+//
+//	events.SortWith(order.Compare)
+//	collection.Dump(events.Items())
+//	// #[]main.Event [
+//	//   0 => #main.Event {
+//	//     +Region => "us-east" #string
+//	//     +Errors => 7 #int
+//	//   }
+//	//   1 => #main.Event {
+//	//     +Region => "us-east" #string
+//	//     +Errors => 1 #int
+//	//   }
+//	//   2 => #main.Event {
+//	//     +Region => "us-west" #string
+//	//     +Errors => 9 #int
+//	//   }
+//	//   3 => #main.Event {
+//	//     +Region => "us-west" #string
+//	//     +Errors => 3 #int
+//	//   }
+//	// ]
+func By[T any, K Number | ~string](keyFn func(T) K) Comparator[T] {
+	return ByFunc(keyFn, cmp.Compare[K])
+}
+
+// ByDesc returns a Comparator ordering T descending by the key returned from keyFn.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: strings by length, longest first
+//
+//	words := collection.New([]string{"go", "collection", "forj"})
+//	words.SortWith(collection.ByDesc(func(s string) int { return len(s) }).Compare)
+//	collection.Dump(words.Items())
+//	// #[]string [
+//	//   0 => "collection" #string
+//	//   1 => "forj" #string
+//	//   2 => "go" #string
+//	// ]
+func ByDesc[T any, K Number | ~string](keyFn func(T) K) Comparator[T] {
+	return By(keyFn).Reverse()
+}
+
+// ByTime returns a Comparator ordering T ascending by a time.Time key.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Times are compared with time.Time.Compare, so instants in different
+// locations order correctly.
+//
+// Example: oldest first
+//
+//	type Job struct {
+//		Name string
+//		At   time.Time
+//	}
+//
+//	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+//	jobs := collection.New([]Job{
+//		{Name: "b", At: base.Add(time.Hour)},
+//		{Name: "a", At: base},
+//	})
+//
+//	jobs.SortWith(collection.ByTime(func(j Job) time.Time { return j.At }).Compare)
+//	fmt.Println(jobs.Items()[0].Name, jobs.Items()[1].Name)
+//	// a b
+func ByTime[T any](keyFn func(T) time.Time) Comparator[T] {
+	return ByFunc(keyFn, time.Time.Compare)
+}
+
+// ByFunc returns a Comparator ordering T by the key returned from keyFn,
+// using compare to order keys.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// compare must return a negative number when a < b, zero when a == b and a
+// positive number when a > b, like cmp.Compare.
+//
+// Example: case-insensitive names
+//
+//	names := collection.New([]string{"bob", "Alice", "carol"})
+//	names.SortWith(collection.ByFunc(strings.ToLower, strings.Compare).Compare)
+//	collection.Dump(names.Items())
+//	// #[]string [
+//	//   0 => "Alice" #string
+//	//   1 => "bob" #string
+//	//   2 => "carol" #string
+//	// ]
+func ByFunc[T any, K any](keyFn func(T) K, compare func(a, b K) int) Comparator[T] {
+	return Comparator[T]{keys: []sortKey[T]{{
+		compare: func(a, b T) int { return compare(keyFn(a), keyFn(b)) },
+	}}}
+}
+
+// ByPtr returns a Comparator ordering T ascending by a pointer key, where a
+// nil pointer represents a missing value.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Nil keys order as the smallest value unless NilsFirst or NilsLast is used.
+//
+// Example: missing scores last
+//
+//	type Player struct {
+//		Name  string
+//		Score *int
+//	}
+//
+//	five, nine := 5, 9
+//	players := collection.New([]Player{
+//		{Name: "nil"},
+//		{Name: "nine", Score: &nine},
+//		{Name: "five", Score: &five},
+//	})
+//
+//	players.SortWith(collection.ByPtr(func(p Player) *int { return p.Score }).NilsLast().Compare)
+//	for _, p := range players.Items() {
+//		fmt.Println(p.Name)
+//	}
+//	// five
+//	// nine
+//	// nil
+func ByPtr[T any, K Number | ~string](keyFn func(T) *K) Comparator[T] {
+	return Comparator[T]{keys: []sortKey[T]{{
+		compare: func(a, b T) int { return cmp.Compare(*keyFn(a), *keyFn(b)) },
+		isNil:   func(v T) bool { return keyFn(v) == nil },
+	}}}
+}
+
+// Then returns a Comparator that breaks ties in c using next.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: by length, then alphabetically
+//
+//	words := collection.New([]string{"bb", "a", "ab", "c"})
+//	order := collection.By(func(s string) int { return len(s) }).
+//		Then(collection.By(func(s string) string { return s }))
+//	words.SortWith(order.Compare)
+//	collection.Dump(words.Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "c" #string
+//	//   2 => "ab" #string
+//	//   3 => "bb" #string
+//	// ]
+func (c Comparator[T]) Then(next Comparator[T]) Comparator[T] {
+	keys := make([]sortKey[T], 0, len(c.keys)+len(next.keys))
+	keys = append(keys, c.keys...)
+	keys = append(keys, next.keys...)
+	return Comparator[T]{keys: keys}
+}
+
+// ThenDesc returns a Comparator that breaks ties in c using next in
+// reverse order.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: by length, then reverse alphabetically
+//
+//	words := collection.New([]string{"a", "bb", "ab", "c"})
+//	order := collection.By(func(s string) int { return len(s) }).
+//		ThenDesc(collection.By(func(s string) string { return s }))
+//	words.SortWith(order.Compare)
+//	collection.Dump(words.Items())
+//	// #[]string [
+//	//   0 => "c" #string
+//	//   1 => "a" #string
+//	//   2 => "bb" #string
+//	//   3 => "ab" #string
+//	// ]
+func (c Comparator[T]) ThenDesc(next Comparator[T]) Comparator[T] {
+	return c.Then(next.Reverse())
+}
+
+// Reverse returns a Comparator with every key's direction flipped.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Explicit NilsFirst / NilsLast placements are kept as-is.
+//
+// Example: integers descending
+//
+//	nums := collection.New([]int{2, 3, 1})
+//	nums.SortWith(collection.By(func(v int) int { return v }).Reverse().Compare)
+//	collection.Dump(nums.Items())
+//	// #[]int [
+//	//   0 => 3 #int
+//	//   1 => 2 #int
+//	//   2 => 1 #int
+//	// ]
+func (c Comparator[T]) Reverse() Comparator[T] {
+	keys := make([]sortKey[T], len(c.keys))
+	for i, k := range c.keys {
+		k.desc = !k.desc
+		keys[i] = k
+	}
+	return Comparator[T]{keys: keys}
+}
+
+// NilsFirst returns a Comparator that places nil keys of the most recently
+// added nil-aware key (see ByPtr) before all other values, in either direction.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: missing scores first, even when descending
+//
+//	type Player struct {
+//		Name  string
+//		Score *int
+//	}
+//
+//	five := 5
+//	players := collection.New([]Player{
+//		{Name: "five", Score: &five},
+//		{Name: "nil"},
+//	})
+//
+//	order := collection.ByPtr(func(p Player) *int { return p.Score }).Reverse().NilsFirst()
+//	players.SortWith(order.Compare)
+//	fmt.Println(players.Items()[0].Name)
+//	// nil
+func (c Comparator[T]) NilsFirst() Comparator[T] {
+	return c.withNils(nilsFirst)
+}
+
+// NilsLast returns a Comparator that places nil keys of the most recently
+// added nil-aware key (see ByPtr) after all other values, in either direction.
+// @group Ordering
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: missing scores last
+//
+//	type Player struct {
+//		Name  string
+//		Score *int
+//	}
+//
+//	five := 5
+//	players := collection.New([]Player{
+//		{Name: "nil"},
+//		{Name: "five", Score: &five},
+//	})
+//
+//	players.SortWith(collection.ByPtr(func(p Player) *int { return p.Score }).NilsLast().Compare)
+//	fmt.Println(players.Items()[1].Name)
+//	// nil
+func (c Comparator[T]) NilsLast() Comparator[T] {
+	return c.withNils(nilsLast)
+}
+
+func (c Comparator[T]) withNils(p nilPlacement) Comparator[T] {
+	keys := make([]sortKey[T], len(c.keys))
+	copy(keys, c.keys)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i].isNil != nil {
+			keys[i].nils = p
+			break
+		}
+	}
+	return Comparator[T]{keys: keys}
+}
+
+// Compare returns a negative number when a orders before b, a positive
+// number when a orders after b, and zero when they are equal on every key.
+// @group Ordering
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The method value c.Compare plugs into SortWith, MinWith, MaxWith and
+// slices.SortFunc.
+//
+// Example: direct comparison
+//
+//	byLen := collection.By(func(s string) int { return len(s) })
+//	fmt.Println(byLen.Compare("go", "forj"))
+//	// -1
+func (c Comparator[T]) Compare(a, b T) int {
+	for _, k := range c.keys {
+		if r := k.compareKey(a, b); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// Less reports whether a orders strictly before b.
+// @group Ordering
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The method value c.Less plugs into Sort.
+//
+// Example: with Sort
+//
+//	nums := collection.New([]int{3, 1, 2})
+//	nums.Sort(collection.By(func(v int) int { return v }).Less)
+//	collection.Dump(nums.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func (c Comparator[T]) Less(a, b T) bool {
+	return c.Compare(a, b) < 0
+}
@@ -0,0 +1,164 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type cmpRow struct {
+	Region string
+	Errors int
+	Score  *int
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestComparator_MultiKey(t *testing.T) {
+	rows := []cmpRow{
+		{Region: "b", Errors: 1},
+		{Region: "a", Errors: 2},
+		{Region: "b", Errors: 5},
+		{Region: "a", Errors: 9},
+	}
+
+	order := By(func(r cmpRow) string { return r.Region }).
+		ThenDesc(By(func(r cmpRow) int { return r.Errors }))
+
+	New(rows).SortWith(order.Compare)
+
+	expected := []cmpRow{
+		{Region: "a", Errors: 9},
+		{Region: "a", Errors: 2},
+		{Region: "b", Errors: 5},
+		{Region: "b", Errors: 1},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("expected %v, got %v", expected, rows)
+	}
+}
+
+func TestComparator_CompareAndLess(t *testing.T) {
+	byLen := By(func(s string) int { return len(s) })
+
+	if byLen.Compare("a", "bb") != -1 || byLen.Compare("bb", "a") != 1 || byLen.Compare("a", "b") != 0 {
+		t.Fatalf("unexpected Compare results")
+	}
+	if !byLen.Less("a", "bb") || byLen.Less("a", "b") {
+		t.Fatalf("unexpected Less results")
+	}
+}
+
+func TestComparator_ZeroValueComparesEqual(t *testing.T) {
+	var c Comparator[int]
+
+	if c.Compare(1, 2) != 0 {
+		t.Fatalf("zero comparator should treat all values as equal")
+	}
+}
+
+func TestComparator_ByDescAndReverse(t *testing.T) {
+	desc := ByDesc(func(v int) int { return v })
+
+	if desc.Compare(1, 2) <= 0 {
+		t.Fatalf("ByDesc should order larger values first")
+	}
+	if desc.Reverse().Compare(1, 2) >= 0 {
+		t.Fatalf("Reverse of ByDesc should be ascending")
+	}
+}
+
+func TestComparator_IsImmutable(t *testing.T) {
+	base := By(func(v int) int { return v % 10 })
+	a := base.Then(By(func(v int) int { return v }))
+	b := base.ThenDesc(By(func(v int) int { return v }))
+
+	if a.Compare(11, 21) >= 0 {
+		t.Fatalf("a should break ties ascending")
+	}
+	if b.Compare(11, 21) <= 0 {
+		t.Fatalf("b should break ties descending")
+	}
+	if base.Compare(11, 21) != 0 {
+		t.Fatalf("base should be unchanged")
+	}
+}
+
+func TestComparator_ByTime(t *testing.T) {
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	earlier := base.Add(-time.Hour).In(time.FixedZone("X", 5*3600))
+
+	byTime := ByTime(func(t time.Time) time.Time { return t })
+
+	if byTime.Compare(earlier, base) >= 0 {
+		t.Fatalf("expected earlier instant to order first regardless of zone")
+	}
+}
+
+func TestComparator_ByFunc(t *testing.T) {
+	type pair struct{ a, b int }
+	bySum := ByFunc(func(p pair) int { return p.a + p.b }, func(x, y int) int { return x - y })
+
+	if bySum.Compare(pair{1, 1}, pair{0, 3}) >= 0 {
+		t.Fatalf("expected smaller sum first")
+	}
+}
+
+func TestComparator_ByPtrDefaultNilsSmallest(t *testing.T) {
+	rows := []cmpRow{{Score: intPtr(2)}, {}, {Score: intPtr(1)}}
+	order := ByPtr(func(r cmpRow) *int { return r.Score })
+
+	New(rows).SortWith(order.Compare)
+
+	if rows[0].Score != nil || *rows[1].Score != 1 || *rows[2].Score != 2 {
+		t.Fatalf("expected nil first then ascending, got %v", rows)
+	}
+
+	New(rows).SortWith(order.Reverse().Compare)
+
+	if *rows[0].Score != 2 || *rows[1].Score != 1 || rows[2].Score != nil {
+		t.Fatalf("expected descending with nil last, got %v", rows)
+	}
+}
+
+func TestComparator_NilsLastAndFirstAreDirectionIndependent(t *testing.T) {
+	key := func(r cmpRow) *int { return r.Score }
+
+	for _, order := range []Comparator[cmpRow]{
+		ByPtr(key).NilsLast(),
+		ByPtr(key).Reverse().NilsLast(),
+		ByPtr(key).NilsLast().Reverse(),
+	} {
+		rows := []cmpRow{{}, {Score: intPtr(1)}, {Score: intPtr(2)}}
+		New(rows).SortWith(order.Compare)
+		if rows[2].Score != nil {
+			t.Fatalf("expected nil last, got %v", rows)
+		}
+	}
+
+	rows := []cmpRow{{Score: intPtr(1)}, {}}
+	New(rows).SortWith(ByPtr(key).Reverse().NilsFirst().Compare)
+	if rows[0].Score != nil {
+		t.Fatalf("expected nil first, got %v", rows)
+	}
+}
+
+func TestComparator_NilsAppliesToLastNilAwareKey(t *testing.T) {
+	order := ByPtr(func(r cmpRow) *int { return r.Score }).
+		Then(By(func(r cmpRow) string { return r.Region })).
+		NilsLast()
+
+	if order.Compare(cmpRow{}, cmpRow{Score: intPtr(1)}) <= 0 {
+		t.Fatalf("expected NilsLast to apply to the ByPtr key")
+	}
+}
+
+func TestComparator_WithSort(t *testing.T) {
+	c := New([]int{3, 1, 2})
+
+	c.Sort(By(func(v int) int { return v }).Less)
+
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", c.Items())
+	}
+}
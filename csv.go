@@ -0,0 +1,228 @@
+package collection
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"iter"
+)
+
+// ToCSV writes the collection to w as comma-separated CSV, one record per item.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// If header is non-nil, it is written first. rowFn maps each item to its
+// fields; no reflection is used. Fields are quoted only when needed, as
+// encoding/csv does. Use WriteCSV to control the delimiter or line endings.
+//
+// Records are written through a buffered csv.Writer as they are produced,
+// and the writer is flushed before returning.
+//
+// Example: exporting structs
+//
+//	type Invoice struct {
+//		ID     int
+//		Client string
+//		Total  float64
+//	}
+//
+//	invoices := collection.New([]Invoice{
+//		{ID: 1, Client: "Acme, Inc.", Total: 120.5},
+//		{ID: 2, Client: "Globex", Total: 80},
+//	})
+//
+//	_ = invoices.ToCSV(os.Stdout, []string{"id", "client", "total"}, func(i Invoice) []string {
+//		return []string{strconv.Itoa(i.ID), i.Client, strconv.FormatFloat(i.Total, 'f', 2, 64)}
+//	})
+//	// id,client,total
+//	// 1,"Acme, Inc.",120.50
+//	// 2,Globex,80.00
+func (c *Collection[T]) ToCSV(w io.Writer, header []string, rowFn func(T) []string) error {
+	return c.WriteCSV(csv.NewWriter(w), header, rowFn)
+}
+
+// WriteCSV writes the collection to a configured csv.Writer, one record per
+// item, and flushes it.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Set cw.Comma to change the delimiter and cw.UseCRLF for CRLF line endings.
+// If header is non-nil, it is written first.
+//
+// Example: semicolon-separated
+//
+//	cw := csv.NewWriter(os.Stdout)
+//	cw.Comma = ';'
+//
+//	_ = collection.New([]string{"a", "b;c"}).WriteCSV(cw, []string{"value"}, func(s string) []string {
+//		return []string{s}
+//	})
+//	// value
+//	// a
+//	// "b;c"
+func (c *Collection[T]) WriteCSV(cw *csv.Writer, header []string, rowFn func(T) []string) error {
+	if header != nil {
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+	for _, v := range c.items {
+		if err := cw.Write(rowFn(v)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// FromCSV reads comma-separated CSV from r into a new collection, using
+// parseFn to turn each record into an item.
+// @group Serialization
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// If hasHeader is true, the first record is skipped. Every record must have
+// the same number of fields as the first one.
+//
+// FromCSV stops at the first malformed record or parseFn error and returns
+// the items parsed so far together with a *LineError carrying the line number.
+// Use ReadCSV to stream records, skip bad rows, or change the delimiter.
+//
+// Example: parsing rows
+//
+//	type Txn struct {
+//		Account string
+//		Amount  int
+//	}
+//
+//	input := "account,amount\nacme,120\nglobex,80\n"
+//
+//	txns, err := collection.FromCSV(strings.NewReader(input), true, func(rec []string) (Txn, error) {
+//		n, err := strconv.Atoi(rec[1])
+//		return Txn{Account: rec[0], Amount: n}, err
+//	})
+//	collection.Dump(txns.Items())
+//	fmt.Println(err)
+//	// #[]main.Txn [
+//	//   0 => #main.Txn {
+//	//     +Account => "acme" #string
+//	//     +Amount  => 120 #int
+//	//   }
+//	//   1 => #main.Txn {
+//	//     +Account => "globex" #string
+//	//     +Amount  => 80 #int
+//	//   }
+//	// ]
+//	// <nil>
+//
+// Example: error with line number
+//
+//	_, err2 := collection.FromCSV(strings.NewReader("a\n1\nx\n"), true, func(rec []string) (int, error) {
+//		return strconv.Atoi(rec[0])
+//	})
+//	fmt.Println(err2)
+//	// line 3: strconv.Atoi: parsing "x": invalid syntax
+func FromCSV[T any](r io.Reader, hasHeader bool, parseFn func(record []string) (T, error)) (*Collection[T], error) {
+	items := []T{}
+	for v, err := range ReadCSV(csv.NewReader(r), hasHeader, parseFn) {
+		if err != nil {
+			return New(items), err
+		}
+		items = append(items, v)
+	}
+	return New(items), nil
+}
+
+// ReadCSV streams records from a configured csv.Reader, yielding each parsed
+// item or the error for that record.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Records are read one at a time as the sequence is consumed; the input is
+// never buffered in full. Configure cr before calling, for example Comma for
+// the delimiter, LazyQuotes for lenient quoting, Comment, or FieldsPerRecord.
+//
+// If hasHeader is true, the first record is skipped. Malformed records and
+// parseFn failures yield a zero item with a *LineError, and iteration
+// continues with the next record, so callers can skip or collect bad rows
+// by continuing, or stop by breaking. An I/O error from the underlying
+// reader is yielded once and ends the sequence.
+//
+// Example: tab-separated, skipping bad rows
+//
+//	cr := csv.NewReader(strings.NewReader("name\tage\nann\t31\nbob\t?\ncy\t27\n"))
+//	cr.Comma = '\t'
+//
+//	type Person struct {
+//		Name string
+//		Age  int
+//	}
+//
+//	rows := collection.ReadCSV(cr, true, func(rec []string) (Person, error) {
+//		age, err := strconv.Atoi(rec[1])
+//		return Person{Name: rec[0], Age: age}, err
+//	})
+//
+//	for p, err := range rows {
+//		if err != nil {
+//			fmt.Println("skip:", err)
+//			continue
+//		}
+//		fmt.Println(p.Name, p.Age)
+//	}
+//	// ann 31
+//	// skip: line 3: strconv.Atoi: parsing "?": invalid syntax
+//	// cy 27
+func ReadCSV[T any](cr *csv.Reader, hasHeader bool, parseFn func(record []string) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		first := true
+
+		for {
+			record, err := cr.Read()
+			if err == io.EOF {
+				return
+			}
+
+			if err != nil {
+				var pe *csv.ParseError
+				if !errors.As(err, &pe) {
+					yield(zero, err)
+					return
+				}
+				if !yield(zero, &LineError{Line: pe.StartLine, Err: pe.Err}) {
+					return
+				}
+				first = false
+				continue
+			}
+
+			if first {
+				first = false
+				if hasHeader {
+					continue
+				}
+			}
+
+			v, err := parseFn(record)
+			if err != nil {
+				line, _ := cr.FieldPos(0)
+				if !yield(zero, &LineError{Line: line, Err: err}) {
+					return
+				}
+				continue
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
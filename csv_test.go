@@ -0,0 +1,202 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func atoiRecord(rec []string) (int, error) {
+	return strconv.Atoi(rec[0])
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestToCSV_HeaderAndQuoting(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := New([]string{"plain", "a,b", `say "hi"`}).ToCSV(&buf, []string{"text"}, func(s string) []string {
+		return []string{s}
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "text\nplain\n\"a,b\"\n\"say \"\"hi\"\"\"\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestToCSV_NoHeader(t *testing.T) {
+	var buf bytes.Buffer
+
+	_ = New([]int{1, 2}).ToCSV(&buf, nil, func(v int) []string { return []string{strconv.Itoa(v)} })
+
+	if buf.String() != "1\n2\n" {
+		t.Fatalf("unexpected output: %q", buf.String())
+	}
+}
+
+func TestToCSV_PropagatesWriteError(t *testing.T) {
+	err := New([]int{1}).ToCSV(failingWriter{}, nil, func(v int) []string { return []string{"x"} })
+
+	if err == nil || err.Error() != "disk full" {
+		t.Fatalf("expected write error, got %v", err)
+	}
+}
+
+func TestWriteCSV_DelimiterAndCRLF(t *testing.T) {
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	cw.Comma = '|'
+	cw.UseCRLF = true
+
+	_ = New([][]string{{"a", "b"}}).WriteCSV(cw, nil, func(r []string) []string { return r })
+
+	if buf.String() != "a|b\r\n" {
+		t.Fatalf("unexpected output: %q", buf.String())
+	}
+}
+
+func TestFromCSV_RoundTrip(t *testing.T) {
+	type row struct {
+		Name  string
+		Score int
+	}
+	src := New([]row{{"ann", 3}, {"b, c", 7}})
+
+	var buf bytes.Buffer
+	_ = src.ToCSV(&buf, []string{"name", "score"}, func(r row) []string {
+		return []string{r.Name, strconv.Itoa(r.Score)}
+	})
+
+	out, err := FromCSV(&buf, true, func(rec []string) (row, error) {
+		n, err := strconv.Atoi(rec[1])
+		return row{rec[0], n}, err
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), src.Items()) {
+		t.Fatalf("round trip mismatch: %v", out.Items())
+	}
+}
+
+func TestFromCSV_StopsAtFirstErrorWithLine(t *testing.T) {
+	out, err := FromCSV(strings.NewReader("1\n2\nx\n4\n"), false, atoiRecord)
+
+	var ce *LineError
+	if !errors.As(err, &ce) || ce.Line != 3 {
+		t.Fatalf("expected LineError on line 3, got %v", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("expected the parse error to be unwrappable, got %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), []int{1, 2}) {
+		t.Fatalf("expected partial [1 2], got %v", out.Items())
+	}
+}
+
+func TestFromCSV_FieldCountError(t *testing.T) {
+	_, err := FromCSV(strings.NewReader("a,b\n1,2\n3\n"), true, atoiRecord)
+
+	var ce *LineError
+	if !errors.As(err, &ce) || ce.Line != 3 || !errors.Is(err, csv.ErrFieldCount) {
+		t.Fatalf("expected field count error on line 3, got %v", err)
+	}
+}
+
+func TestFromCSV_EmptyInput(t *testing.T) {
+	out, err := FromCSV(strings.NewReader(""), true, atoiRecord)
+
+	if err != nil || out.Items() == nil || len(out.Items()) != 0 {
+		t.Fatalf("expected empty non-nil result, got %v, %v", out.Items(), err)
+	}
+}
+
+func TestReadCSV_ContinuesPastBadRows(t *testing.T) {
+	cr := csv.NewReader(strings.NewReader("1\n\"2\"x\nnope\n4\n"))
+
+	var good []int
+	var lines []int
+	for v, err := range ReadCSV(cr, false, atoiRecord) {
+		if err != nil {
+			var ce *LineError
+			if !errors.As(err, &ce) {
+				t.Fatalf("expected *LineError, got %T", err)
+			}
+			lines = append(lines, ce.Line)
+			continue
+		}
+		good = append(good, v)
+	}
+
+	if !reflect.DeepEqual(good, []int{1, 4}) {
+		t.Fatalf("expected [1 4], got %v", good)
+	}
+	if !reflect.DeepEqual(lines, []int{2, 3}) {
+		t.Fatalf("expected errors on lines [2 3], got %v", lines)
+	}
+}
+
+func TestReadCSV_LineNumbersWithMultilineFields(t *testing.T) {
+	cr := csv.NewReader(strings.NewReader("h\n\"multi\nline\"\nbad\n"))
+
+	for _, err := range ReadCSV(cr, true, func(rec []string) (string, error) {
+		if rec[0] == "bad" {
+			return "", errors.New("rejected")
+		}
+		return rec[0], nil
+	}) {
+		if err != nil {
+			var ce *LineError
+			if !errors.As(err, &ce) || ce.Line != 4 {
+				t.Fatalf("expected error on line 4, got %v", err)
+			}
+			return
+		}
+	}
+	t.Fatalf("expected an error")
+}
+
+func TestReadCSV_StopsWhenConsumerBreaks(t *testing.T) {
+	calls := 0
+	cr := csv.NewReader(strings.NewReader("1\n2\n3\n"))
+
+	for range ReadCSV(cr, false, func(rec []string) (int, error) {
+		calls++
+		return atoiRecord(rec)
+	}) {
+		break
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected 1 parse call, got %d", calls)
+	}
+}
+
+func TestReadCSV_LazyQuotesAndDelimiter(t *testing.T) {
+	cr := csv.NewReader(strings.NewReader("a;b \"c\"\n"))
+	cr.Comma = ';'
+	cr.LazyQuotes = true
+
+	var got [][]string
+	for rec, err := range ReadCSV(cr, false, func(rec []string) ([]string, error) { return rec, nil }) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, rec)
+	}
+
+	if !reflect.DeepEqual(got, [][]string{{"a", `b "c"`}}) {
+		t.Fatalf("unexpected records: %q", got)
+	}
+}
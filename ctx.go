@@ -0,0 +1,226 @@
+package collection
+
+import "context"
+
+// EachCtx runs fn for every item, checking ctx for cancellation before each one.
+// @group Context
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// If ctx is done before all items are visited, EachCtx stops and returns
+// ctx.Err(). Items already passed to fn are not revisited.
+//
+// Example: integers
+//
+//	ctx := context.Background()
+//	err := collection.New([]int{1, 2, 3}).EachCtx(ctx, func(ctx context.Context, v int) {
+//		fmt.Println(v)
+//	})
+//	fmt.Println(err)
+//	// 1
+//	// 2
+//	// 3
+//	// <nil>
+//
+// Example: cancellation stops iteration
+//
+//	ctx2, cancel := context.WithCancel(context.Background())
+//	err2 := collection.New([]int{1, 2, 3}).EachCtx(ctx2, func(ctx context.Context, v int) {
+//		fmt.Println(v)
+//		if v == 2 {
+//			cancel()
+//		}
+//	})
+//	fmt.Println(err2)
+//	// 1
+//	// 2
+//	// context canceled
+func (c *Collection[T]) EachCtx(ctx context.Context, fn func(context.Context, T)) error {
+	for _, v := range c.items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		fn(ctx, v)
+	}
+	return nil
+}
+
+// FilterCtx keeps only the elements for which fn returns true, checking ctx
+// for cancellation before each item.
+// This method mutates the collection in place and returns the same instance.
+// @group Context
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// Predicates are evaluated before any item moves. If ctx is done part way
+// through, FilterCtx returns ctx.Err() and leaves the collection unchanged.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 3, 4})
+//	_, err := c.FilterCtx(context.Background(), func(ctx context.Context, v int) bool {
+//		return v%2 == 0
+//	})
+//	collection.Dump(c.Items())
+//	fmt.Println(err)
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 4 #int
+//	// ]
+//	// <nil>
+func (c *Collection[T]) FilterCtx(ctx context.Context, fn func(context.Context, T) bool) (*Collection[T], error) {
+	items := c.items
+	keep := make([]bool, len(items))
+
+	for i, v := range items {
+		if err := ctx.Err(); err != nil {
+			return c, err
+		}
+		keep[i] = fn(ctx, v)
+	}
+
+	j := 0
+	for i := range items {
+		if keep[i] {
+			items[j] = items[i]
+			j++
+		}
+	}
+
+	clear(items[j:])
+	c.items = items[:j]
+	return c, nil
+}
+
+// MapToCtx maps a Collection[T] to a Collection[R], checking ctx for
+// cancellation before each item.
+// @group Context
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// If ctx is done part way through, MapToCtx returns ctx.Err() together with
+// a collection of the results produced so far.
+//
+// Example: integers
+//
+//	nums := collection.New([]int{1, 2, 3})
+//	labels, err := collection.MapToCtx(context.Background(), nums, func(ctx context.Context, n int) string {
+//		return fmt.Sprintf("#%d", n)
+//	})
+//	collection.Dump(labels.Items())
+//	fmt.Println(err)
+//	// #[]string [
+//	//   0 => "#1" #string
+//	//   1 => "#2" #string
+//	//   2 => "#3" #string
+//	// ]
+//	// <nil>
+func MapToCtx[T any, R any](ctx context.Context, c *Collection[T], fn func(context.Context, T) R) (*Collection[R], error) {
+	items := c.items
+	out := make([]R, 0, len(items))
+	for _, v := range items {
+		if err := ctx.Err(); err != nil {
+			return New(out), err
+		}
+		out = append(out, fn(ctx, v))
+	}
+	return New(out), nil
+}
+
+// GroupByCtx partitions the collection into groups keyed by keyFn, checking
+// ctx for cancellation before each item.
+// @group Context
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// If ctx is done part way through, GroupByCtx returns ctx.Err() together
+// with the groups built so far. Like GroupBy, group order is unspecified.
+//
+// Example: grouping integers by parity
+//
+//	nums := collection.New([]int{1, 2, 3, 4})
+//	groups, err := collection.GroupByCtx(context.Background(), nums, func(ctx context.Context, v int) string {
+//		if v%2 == 0 {
+//			return "even"
+//		}
+//		return "odd"
+//	})
+//	collection.Dump(groups["even"].Items())
+//	fmt.Println(err)
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 4 #int
+//	// ]
+//	// <nil>
+func GroupByCtx[T any, K comparable](
+	ctx context.Context,
+	c *Collection[T],
+	keyFn func(context.Context, T) K,
+) (map[K]*Collection[T], error) {
+	out := make(map[K]*Collection[T])
+
+	for _, item := range c.items {
+		if err := ctx.Err(); err != nil {
+			return out, err
+		}
+
+		key := keyFn(ctx, item)
+
+		group := out[key]
+		if group == nil {
+			out[key] = &Collection[T]{items: []T{item}}
+			continue
+		}
+
+		group.items = append(group.items, item)
+	}
+
+	return out, nil
+}
+
+// TimesCtx creates a new collection by calling fn(ctx, i) for i = 1..count,
+// checking ctx for cancellation before each call.
+// @group Context
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// Indexing mirrors Times. If ctx is done part way through, TimesCtx returns
+// ctx.Err() together with the items produced so far.
+//
+// Example: cancellation part way through
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	out, err := collection.TimesCtx(ctx, 5, func(ctx context.Context, i int) int {
+//		if i == 3 {
+//			cancel()
+//		}
+//		return i * 10
+//	})
+//	collection.Dump(out.Items())
+//	fmt.Println(err)
+//	// #[]int [
+//	//   0 => 10 #int
+//	//   1 => 20 #int
+//	//   2 => 30 #int
+//	// ]
+//	// context canceled
+func TimesCtx[T any](ctx context.Context, count int, fn func(context.Context, int) T) (*Collection[T], error) {
+	if count <= 0 {
+		return New([]T{}), nil
+	}
+
+	out := make([]T, 0, count)
+	for i := 1; i <= count; i++ {
+		if err := ctx.Err(); err != nil {
+			return New(out), err
+		}
+		out = append(out, fn(ctx, i))
+	}
+
+	return New(out), nil
+}
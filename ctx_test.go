@@ -0,0 +1,261 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type ctxKey struct{}
+
+func TestEachCtx_VisitsAllItems(t *testing.T) {
+	var seen []int
+	err := New([]int{1, 2, 3}).EachCtx(context.Background(), func(ctx context.Context, v int) {
+		seen = append(seen, v)
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Fatalf("unexpected items: %v", seen)
+	}
+}
+
+func TestEachCtx_PassesContextToCallback(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+
+	_ = New([]int{1}).EachCtx(ctx, func(got context.Context, _ int) {
+		if got.Value(ctxKey{}) != "v" {
+			t.Fatalf("callback did not receive the caller's context")
+		}
+	})
+}
+
+func TestEachCtx_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var seen []int
+	err := New([]int{1, 2, 3, 4}).EachCtx(ctx, func(ctx context.Context, v int) {
+		seen = append(seen, v)
+		if v == 2 {
+			cancel()
+		}
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2}) {
+		t.Fatalf("expected iteration to stop after 2, saw %v", seen)
+	}
+}
+
+func TestEachCtx_AlreadyCancelledDoesNotCall(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := New([]int{1}).EachCtx(ctx, func(context.Context, int) {
+		t.Fatalf("callback should not run on a cancelled context")
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestFilterCtx_Basic(t *testing.T) {
+	c := New([]int{1, 2, 3, 4})
+
+	out, err := c.FilterCtx(context.Background(), func(ctx context.Context, v int) bool {
+		return v > 2
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != c {
+		t.Fatalf("FilterCtx should return the same collection")
+	}
+	if !reflect.DeepEqual(c.Items(), []int{3, 4}) {
+		t.Fatalf("expected [3 4], got %v", c.Items())
+	}
+}
+
+func TestFilterCtx_PartialResultOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	items := []int{1, 2, 3, 4, 5}
+	c := New(items)
+	calls := 0
+
+	_, err := c.FilterCtx(ctx, func(ctx context.Context, v int) bool {
+		calls++
+		if v == 1 {
+			cancel()
+		}
+		return false
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected fn to run once, ran %d times", calls)
+	}
+	// Nothing is compacted on cancel: no item is dropped and no unchecked
+	// item is reported as kept.
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3, 4, 5}) || !reflect.DeepEqual(items, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected collection unchanged, got %v / %v", c.Items(), items)
+	}
+}
+
+func TestFilterCtx_CancelledBeforeStartKeepsEverything(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	items := []int{1, 2, 3}
+	c := New(items)
+	called := false
+
+	_, err := c.FilterCtx(ctx, func(ctx context.Context, v int) bool {
+		called = true
+		return false
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if called {
+		t.Fatalf("fn should not run after cancellation")
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3}) || !reflect.DeepEqual(items, []int{1, 2, 3}) {
+		t.Fatalf("expected collection and source slice unchanged, got %v / %v", c.Items(), items)
+	}
+}
+
+func TestFilterCtx_ClearsVacatedTail(t *testing.T) {
+	a, b, cval, d := 1, 2, 3, 4
+	items := []*int{&a, &b, &cval, &d}
+	c := New(items)
+
+	_, _ = c.FilterCtx(context.Background(), func(ctx context.Context, v *int) bool {
+		return *v != 2
+	})
+
+	if len(c.Items()) != 3 || items[0] != &a || items[1] != &cval || items[2] != &d {
+		t.Fatalf("expected [1 3 4] compacted in the source slice, got %v", c.Items())
+	}
+	if items[3] != nil {
+		t.Fatalf("expected vacated slot to be cleared")
+	}
+}
+
+func TestMapToCtx_PartialResultOnDeadline(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	out, err := MapToCtx(ctx, New([]int{1, 2}), func(ctx context.Context, v int) int {
+		return v
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if len(out.Items()) != 0 {
+		t.Fatalf("expected no results, got %v", out.Items())
+	}
+}
+
+func TestMapToCtx_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	out, err := MapToCtx(ctx, New([]int{1, 2, 3}), func(ctx context.Context, v int) string {
+		if v == 2 {
+			cancel()
+		}
+		return string(rune('a' + v - 1))
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), []string{"a", "b"}) {
+		t.Fatalf("expected partial [a b], got %v", out.Items())
+	}
+}
+
+func TestGroupByCtx_Basic(t *testing.T) {
+	groups, err := GroupByCtx(context.Background(), New([]int{1, 2, 3, 4}), func(ctx context.Context, v int) bool {
+		return v%2 == 0
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(groups[true].Items(), []int{2, 4}) {
+		t.Fatalf("unexpected even group: %v", groups[true].Items())
+	}
+	if !reflect.DeepEqual(groups[false].Items(), []int{1, 3}) {
+		t.Fatalf("unexpected odd group: %v", groups[false].Items())
+	}
+}
+
+func TestGroupByCtx_PartialOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	groups, err := GroupByCtx(ctx, New([]int{1, 2, 3}), func(ctx context.Context, v int) int {
+		cancel()
+		return v
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(groups) != 1 || groups[1] == nil {
+		t.Fatalf("expected only the first group, got %v", groups)
+	}
+}
+
+func TestTimesCtx_Basic(t *testing.T) {
+	out, err := TimesCtx(context.Background(), 3, func(ctx context.Context, i int) int {
+		return i
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", out.Items())
+	}
+}
+
+func TestTimesCtx_NonPositiveCount(t *testing.T) {
+	out, err := TimesCtx(context.Background(), 0, func(ctx context.Context, i int) int {
+		t.Fatalf("fn should not be called")
+		return 0
+	})
+
+	if err != nil || out.Items() == nil || len(out.Items()) != 0 {
+		t.Fatalf("expected empty non-nil result and nil error, got %v, %v", out.Items(), err)
+	}
+}
+
+func TestTimesCtx_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	out, err := TimesCtx(ctx, 10, func(ctx context.Context, i int) int {
+		if i == 2 {
+			cancel()
+		}
+		return i
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", out.Items())
+	}
+}
@@ -0,0 +1,104 @@
+package collection
+
+// CumSum returns a new collection of running totals.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Item i of the result is the sum of items 0..i.
+//
+// Example: cumulative revenue
+//
+//	revenue := collection.NewNumeric([]int{10, 20, 5, 15})
+//	collection.Dump(revenue.CumSum().Items())
+//	// #[]int [
+//	//   0 => 10 #int
+//	//   1 => 30 #int
+//	//   2 => 35 #int
+//	//   3 => 50 #int
+//	// ]
+func (c *NumericCollection[T]) CumSum() *NumericCollection[T] {
+	return c.cumulative(func(acc, v T) T { return acc + v })
+}
+
+// CumProd returns a new collection of running products.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Item i of the result is the product of items 0..i.
+//
+// Example: compounding growth factors
+//
+//	growth := collection.NewNumeric([]float64{1.1, 1.2, 0.5})
+//	collection.Dump(growth.CumProd().Items())
+//	// #[]float64 [
+//	//   0 => 1.100000 #float64
+//	//   1 => 1.320000 #float64
+//	//   2 => 0.660000 #float64
+//	// ]
+func (c *NumericCollection[T]) CumProd() *NumericCollection[T] {
+	return c.cumulative(func(acc, v T) T { return acc * v })
+}
+
+// CumMax returns a new collection of running maxima.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Item i of the result is the largest of items 0..i.
+//
+// Example: high-water mark
+//
+//	c := collection.NewNumeric([]int{3, 1, 4, 1, 5})
+//	collection.Dump(c.CumMax().Items())
+//	// #[]int [
+//	//   0 => 3 #int
+//	//   1 => 3 #int
+//	//   2 => 4 #int
+//	//   3 => 4 #int
+//	//   4 => 5 #int
+//	// ]
+func (c *NumericCollection[T]) CumMax() *NumericCollection[T] {
+	return c.cumulative(func(acc, v T) T { return max(acc, v) })
+}
+
+// CumMin returns a new collection of running minima.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Item i of the result is the smallest of items 0..i.
+//
+// Example: low-water mark
+//
+//	c := collection.NewNumeric([]int{3, 4, 1, 5, 0})
+//	collection.Dump(c.CumMin().Items())
+//	// #[]int [
+//	//   0 => 3 #int
+//	//   1 => 3 #int
+//	//   2 => 1 #int
+//	//   3 => 1 #int
+//	//   4 => 0 #int
+//	// ]
+func (c *NumericCollection[T]) CumMin() *NumericCollection[T] {
+	return c.cumulative(func(acc, v T) T { return min(acc, v) })
+}
+
+// cumulative folds items left to right, seeding the accumulator with the
+// first item, and returns every intermediate value.
+func (c *NumericCollection[T]) cumulative(fn func(acc, v T) T) *NumericCollection[T] {
+	out := make([]T, len(c.items))
+	for i, v := range c.items {
+		if i == 0 {
+			out[i] = v
+			continue
+		}
+		out[i] = fn(out[i-1], v)
+	}
+	return NewNumeric(out)
+}
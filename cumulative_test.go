@@ -0,0 +1,54 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCumSum(t *testing.T) {
+	c := NewNumeric([]int{1, 2, 3})
+
+	out := c.CumSum()
+
+	if !reflect.DeepEqual(out.Items(), []int{1, 3, 6}) {
+		t.Fatalf("expected [1 3 6], got %v", out.Items())
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3}) {
+		t.Fatalf("source was mutated: %v", c.Items())
+	}
+}
+
+func TestCumProd(t *testing.T) {
+	out := NewNumeric([]int{2, 3, 4}).CumProd()
+
+	if !reflect.DeepEqual(out.Items(), []int{2, 6, 24}) {
+		t.Fatalf("expected [2 6 24], got %v", out.Items())
+	}
+}
+
+func TestCumMaxAndCumMin(t *testing.T) {
+	c := NewNumeric([]float64{2, -1, 5, 3})
+
+	if got := c.CumMax().Items(); !reflect.DeepEqual(got, []float64{2, 2, 5, 5}) {
+		t.Fatalf("unexpected CumMax: %v", got)
+	}
+	if got := c.CumMin().Items(); !reflect.DeepEqual(got, []float64{2, -1, -1, -1}) {
+		t.Fatalf("unexpected CumMin: %v", got)
+	}
+}
+
+func TestCumulative_Empty(t *testing.T) {
+	c := NewNumeric([]int{})
+
+	if got := c.CumSum().Items(); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil result, got %#v", got)
+	}
+}
+
+func TestCumSum_ChainsWithNumericMethods(t *testing.T) {
+	sum := NewNumeric([]int{1, 2, 3}).CumSum().Sum()
+
+	if sum != 10 {
+		t.Fatalf("expected 10, got %d", sum)
+	}
+}
@@ -0,0 +1,235 @@
+package collection
+
+import (
+	"cmp"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+// ErrInvalidCursor is returned by CursorPaginate when a cursor cannot be
+// decoded, has been modified, or does not hold a key of the expected type.
+var ErrInvalidCursor = errors.New("collection: invalid cursor")
+
+// CursorPage is one page of a keyset-paginated collection.
+//
+// Items is a view over the source collection (it shares the backing array).
+// NextCursor and PrevCursor are empty when there is nothing further in that
+// direction; otherwise they can be passed back to CursorPaginate.
+type CursorPage[T any] struct {
+	Items      *Collection[T] `json:"data"`
+	NextCursor string         `json:"next_cursor"`
+	PrevCursor string         `json:"prev_cursor"`
+}
+
+// CursorSigner signs and verifies the cursors issued by CursorPaginate with
+// HMAC-SHA256 under a server-side secret.
+//
+// Create one with NewCursorSigner and share it between requests; it is safe
+// for concurrent use. The zero value has no secret and must not be used.
+type CursorSigner struct {
+	secret []byte
+}
+
+// NewCursorSigner returns a CursorSigner keyed by secret.
+// @group Pagination
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// The secret is copied. It should be at least 32 random bytes and must stay
+// on the server: anyone who knows it can mint valid cursors. Rotating it
+// invalidates every cursor issued so far.
+//
+// NewCursorSigner panics if secret is empty.
+//
+// Example: from configuration
+//
+//	signer := collection.NewCursorSigner([]byte("0123456789abcdef0123456789abcdef"))
+//	ids := collection.New([]int{1, 2, 3})
+//	page, _ := collection.CursorPaginate(ids, signer, "", 2, func(v int) int { return v })
+//	fmt.Println(page.NextCursor != "")
+//	// true
+func NewCursorSigner(secret []byte) *CursorSigner {
+	if len(secret) == 0 {
+		panic("collection: NewCursorSigner requires a non-empty secret")
+	}
+	return &CursorSigner{secret: slices.Clone(secret)}
+}
+
+func (s *CursorSigner) sign(b []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(b)
+	return mac.Sum(nil)
+}
+
+type cursorPayload[K any] struct {
+	Prev bool `json:"p,omitempty"`
+	Key  K    `json:"k"`
+}
+
+// CursorPaginate returns up to limit items following (or preceding) the
+// position encoded in cursor, using keyset pagination over keyFn.
+// @group Pagination
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// The collection must be sorted ascending by keyFn and keys must be unique;
+// items are located by binary search. An empty cursor returns the first page.
+// A next cursor returns the items whose key is greater than the encoded key,
+// like After followed by Take(limit); a previous cursor returns the items
+// whose key is less than it, like Before followed by Take(-limit). Because
+// positions are keys rather than offsets, pages stay stable when items are
+// inserted or removed between requests.
+//
+// Cursors are URL-safe base64 of the encoded key followed by an HMAC-SHA256
+// signature from signer. Cursors that were edited, truncated, forged without
+// the secret, or signed by a different signer are rejected with
+// ErrInvalidCursor. The key itself is encoded, not encrypted, so it should
+// not hold anything a client must not see. signer must not be nil.
+//
+// If limit <= 0, or no items lie past the cursor, an empty page with no
+// cursors is returned.
+//
+// This cannot be a method because methods can't introduce a new type parameter K.
+//
+// Example: walking a feed
+//
+//	type Post struct {
+//		ID    int
+//		Title string
+//	}
+//
+//	posts := collection.New([]Post{
+//		{ID: 1, Title: "one"},
+//		{ID: 2, Title: "two"},
+//		{ID: 3, Title: "three"},
+//		{ID: 4, Title: "four"},
+//		{ID: 5, Title: "five"},
+//	})
+//	byID := func(p Post) int { return p.ID }
+//
+//	signer := collection.NewCursorSigner([]byte("0123456789abcdef0123456789abcdef"))
+//	first, _ := collection.CursorPaginate(posts, signer, "", 2, byID)
+//	second, _ := collection.CursorPaginate(posts, signer, first.NextCursor, 2, byID)
+//	collection.Dump(second.Items.Items())
+//	// #[]main.Post [
+//	//   0 => #main.Post {
+//	//     +ID    => 3 #int
+//	//     +Title => "three" #string
+//	//   }
+//	//   1 => #main.Post {
+//	//     +ID    => 4 #int
+//	//     +Title => "four" #string
+//	//   }
+//	// ]
+//
+//	back, _ := collection.CursorPaginate(posts, signer, second.PrevCursor, 2, byID)
+//	collection.Dump(back.Items.Items())
+//	// #[]main.Post [
+//	//   0 => #main.Post {
+//	//     +ID    => 1 #int
+//	//     +Title => "one" #string
+//	//   }
+//	//   1 => #main.Post {
+//	//     +ID    => 2 #int
+//	//     +Title => "two" #string
+//	//   }
+//	// ]
+//	fmt.Println(back.PrevCursor == "", back.NextCursor != "")
+//	// true true
+//
+// Example: rejecting a modified cursor
+//
+//	key := collection.NewCursorSigner([]byte("0123456789abcdef0123456789abcdef"))
+//	ids := collection.New([]int{10, 20, 30})
+//	page, _ := collection.CursorPaginate(ids, key, "", 1, func(v int) int { return v })
+//	_, err := collection.CursorPaginate(ids, key, page.NextCursor+"x", 1, func(v int) int { return v })
+//	fmt.Println(err)
+//	// collection: invalid cursor
+func CursorPaginate[T any, K cmp.Ordered](c *Collection[T], signer *CursorSigner, cursor string, limit int, keyFn func(T) K) (CursorPage[T], error) {
+	items := c.items
+	lo, hi := 0, len(items)
+	limit = max(limit, 0)
+
+	var prev bool
+	if cursor != "" {
+		pos, err := decodeCursor[K](signer, cursor)
+		if err != nil {
+			return CursorPage[T]{Items: New([]T{})}, err
+		}
+
+		i, found := slices.BinarySearchFunc(items, pos.Key, func(v T, k K) int {
+			return cmp.Compare(keyFn(v), k)
+		})
+
+		prev = pos.Prev
+		if prev {
+			hi = i
+		} else if found {
+			lo = i + 1
+		} else {
+			lo = i
+		}
+	}
+
+	if prev {
+		lo = max(lo, hi-limit)
+	} else {
+		hi = min(hi, lo+limit)
+	}
+
+	if lo >= hi {
+		return CursorPage[T]{Items: New([]T{})}, nil
+	}
+
+	out := CursorPage[T]{Items: New(items[lo:hi])}
+
+	if lo > 0 {
+		cur, err := encodeCursor(signer, cursorPayload[K]{Prev: true, Key: keyFn(items[lo])})
+		if err != nil {
+			return out, err
+		}
+		out.PrevCursor = cur
+	}
+	if hi < len(items) {
+		cur, err := encodeCursor(signer, cursorPayload[K]{Key: keyFn(items[hi-1])})
+		if err != nil {
+			return out, err
+		}
+		out.NextCursor = cur
+	}
+
+	return out, nil
+}
+
+func encodeCursor[K any](signer *CursorSigner, p cursorPayload[K]) (string, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(append(b, signer.sign(b)...)), nil
+}
+
+func decodeCursor[K any](signer *CursorSigner, s string) (cursorPayload[K], error) {
+	var p cursorPayload[K]
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(raw) <= sha256.Size {
+		return p, ErrInvalidCursor
+	}
+
+	b, mac := raw[:len(raw)-sha256.Size], raw[len(raw)-sha256.Size:]
+	if !hmac.Equal(mac, signer.sign(b)) {
+		return p, ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return p, ErrInvalidCursor
+	}
+	return p, nil
+}
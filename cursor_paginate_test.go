@@ -0,0 +1,257 @@
+package collection
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var testSigner = NewCursorSigner([]byte("test-secret-0123456789abcdef0123"))
+
+func identity(v int) int { return v }
+
+func TestCursorPaginate_WalkForwardAndBack(t *testing.T) {
+	c := New([]int{1, 2, 3, 4, 5, 6, 7})
+
+	var pages [][]int
+	var page CursorPage[int]
+	cursor := ""
+	for {
+		var err error
+		page, err = CursorPaginate(c, testSigner, cursor, 3, identity)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pages = append(pages, page.Items.Items())
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+	if !reflect.DeepEqual(pages, expected) {
+		t.Fatalf("expected %v, got %v", expected, pages)
+	}
+
+	back, err := CursorPaginate(c, testSigner, page.PrevCursor, 3, identity)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(back.Items.Items(), []int{4, 5, 6}) {
+		t.Fatalf("expected previous page [4 5 6], got %v", back.Items.Items())
+	}
+
+	first, _ := CursorPaginate(c, testSigner, back.PrevCursor, 3, identity)
+	if !reflect.DeepEqual(first.Items.Items(), []int{1, 2, 3}) || first.PrevCursor != "" || first.NextCursor == "" {
+		t.Fatalf("unexpected first page: %+v", first)
+	}
+}
+
+func TestCursorPaginate_PrevShortPage(t *testing.T) {
+	c := New([]int{1, 2, 3, 4, 5})
+
+	p, _ := CursorPaginate(c, testSigner, "", 2, identity)
+	p, _ = CursorPaginate(c, testSigner, p.NextCursor, 2, identity)
+	p, _ = CursorPaginate(c, testSigner, p.PrevCursor, 3, identity)
+
+	if !reflect.DeepEqual(p.Items.Items(), []int{1, 2}) || p.PrevCursor != "" {
+		t.Fatalf("expected [1 2] with no previous cursor, got %v", p.Items.Items())
+	}
+}
+
+func TestCursorPaginate_StableAcrossInserts(t *testing.T) {
+	c := New([]int{10, 20, 30, 40})
+	first, _ := CursorPaginate(c, testSigner, "", 2, identity)
+
+	// A new item lands before the cursor between requests.
+	c = New([]int{5, 10, 20, 30, 40})
+	next, err := CursorPaginate(c, testSigner, first.NextCursor, 2, identity)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(next.Items.Items(), []int{30, 40}) {
+		t.Fatalf("expected [30 40], got %v", next.Items.Items())
+	}
+}
+
+func TestCursorPaginate_CursorKeyMissing(t *testing.T) {
+	c := New([]int{10, 20, 30})
+	page, _ := CursorPaginate(c, testSigner, "", 1, identity)
+
+	// The item the cursor points at has been removed.
+	c = New([]int{30})
+	next, _ := CursorPaginate(c, testSigner, page.NextCursor, 5, identity)
+	if !reflect.DeepEqual(next.Items.Items(), []int{30}) {
+		t.Fatalf("expected [30], got %v", next.Items.Items())
+	}
+}
+
+func TestCursorPaginate_StringKeys(t *testing.T) {
+	type user struct{ Name string }
+	c := New([]user{{"ann"}, {"bob"}, {"cat"}})
+	byName := func(u user) string { return u.Name }
+
+	p, _ := CursorPaginate(c, testSigner, "", 2, byName)
+	p, err := CursorPaginate(c, testSigner, p.NextCursor, 2, byName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(p.Items.Items(), []user{{"cat"}}) || p.NextCursor != "" {
+		t.Fatalf("unexpected page: %+v", p.Items.Items())
+	}
+}
+
+func TestCursorPaginate_EmptyAndInvalidLimit(t *testing.T) {
+	p, err := CursorPaginate(New([]int{}), testSigner, "", 3, identity)
+	if err != nil || !p.Items.IsEmpty() || p.NextCursor != "" || p.PrevCursor != "" {
+		t.Fatalf("unexpected page for empty collection: %+v, %v", p, err)
+	}
+
+	p, _ = CursorPaginate(New([]int{1, 2}), testSigner, "", 0, identity)
+	if !p.Items.IsEmpty() || p.NextCursor != "" {
+		t.Fatalf("expected empty page for limit 0, got %+v", p)
+	}
+}
+
+func TestCursorPaginate_RejectsTampering(t *testing.T) {
+	c := New([]int{1, 2, 3})
+	page, _ := CursorPaginate(c, testSigner, "", 1, identity)
+
+	raw, _ := base64.RawURLEncoding.DecodeString(page.NextCursor)
+	edited := append([]byte{}, raw...)
+	edited[len(edited)-sha256.Size-2] ^= 1
+
+	// Swap in a different key and keep the original signature.
+	payload, _ := json.Marshal(cursorPayload[int]{Key: 2})
+	reused := base64.RawURLEncoding.EncodeToString(append(payload, raw[len(raw)-sha256.Size:]...))
+
+	for _, cur := range []string{
+		"not base64!",
+		"YQ",
+		base64.RawURLEncoding.EncodeToString(edited),
+		reused,
+	} {
+		p, err := CursorPaginate(c, testSigner, cur, 1, identity)
+		if !errors.Is(err, ErrInvalidCursor) {
+			t.Fatalf("expected ErrInvalidCursor for %q, got %v", cur, err)
+		}
+		if p.Items == nil || !p.Items.IsEmpty() {
+			t.Fatalf("expected an empty page on error")
+		}
+	}
+}
+
+func TestCursorPaginate_RejectsForgeryWithoutSecret(t *testing.T) {
+	c := New([]int{1, 2, 3})
+	payload, _ := json.Marshal(cursorPayload[int]{Key: 2})
+
+	// A client that knows the format can re-hash the payload, but not
+	// reproduce the HMAC without the secret.
+	sum := sha256.Sum256(payload)
+	rehashed := base64.RawURLEncoding.EncodeToString(append(payload, sum[:]...))
+
+	guess := hmac.New(sha256.New, []byte("guessed-secret"))
+	guess.Write(payload)
+	wrongKey := base64.RawURLEncoding.EncodeToString(append(payload, guess.Sum(nil)...))
+
+	for _, cur := range []string{rehashed, wrongKey} {
+		if _, err := CursorPaginate(c, testSigner, cur, 1, identity); !errors.Is(err, ErrInvalidCursor) {
+			t.Fatalf("expected forged cursor to be rejected, got %v", err)
+		}
+	}
+
+	// The same payload signed with the real secret is accepted.
+	valid := base64.RawURLEncoding.EncodeToString(append(payload, testSigner.sign(payload)...))
+	p, err := CursorPaginate(c, testSigner, valid, 1, identity)
+	if err != nil || !reflect.DeepEqual(p.Items.Items(), []int{3}) {
+		t.Fatalf("expected signed cursor to be accepted, got %v, %v", p.Items.Items(), err)
+	}
+}
+
+func TestCursorPaginate_RejectsOtherSigner(t *testing.T) {
+	c := New([]int{1, 2, 3})
+	page, _ := CursorPaginate(c, testSigner, "", 1, identity)
+
+	other := NewCursorSigner([]byte("another-secret"))
+	if _, err := CursorPaginate(c, other, page.NextCursor, 1, identity); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected cursor from another signer to be rejected, got %v", err)
+	}
+}
+
+func TestNewCursorSigner_CopiesSecret(t *testing.T) {
+	secret := []byte("mutable-secret")
+	signer := NewCursorSigner(secret)
+	page, _ := CursorPaginate(New([]int{1, 2}), signer, "", 1, identity)
+
+	secret[0] = 'X'
+
+	if _, err := CursorPaginate(New([]int{1, 2}), signer, page.NextCursor, 1, identity); err != nil {
+		t.Fatalf("expected signer to be unaffected by later writes to the secret, got %v", err)
+	}
+}
+
+func TestNewCursorSigner_PanicsOnEmptySecret(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic for empty secret")
+		}
+	}()
+	NewCursorSigner(nil)
+}
+
+func TestCursorPaginate_MissingKeyBinarySearch(t *testing.T) {
+	type row struct{ K, V int }
+	byK := func(r row) int { return r.K }
+	c := New([]row{{1, 0}, {2, 0}, {4, 0}, {6, 0}})
+
+	// Next cursor for a key that is no longer present starts at the next larger key.
+	payload := cursorPayload[int]{Key: 3}
+	cur, _ := encodeCursor(testSigner, payload)
+	p, _ := CursorPaginate(c, testSigner, cur, 2, byK)
+	if !reflect.DeepEqual(p.Items.Items(), []row{{4, 0}, {6, 0}}) {
+		t.Fatalf("unexpected next page: %v", p.Items.Items())
+	}
+
+	payload.Prev = true
+	cur, _ = encodeCursor(testSigner, payload)
+	p, _ = CursorPaginate(c, testSigner, cur, 5, byK)
+	if !reflect.DeepEqual(p.Items.Items(), []row{{1, 0}, {2, 0}}) {
+		t.Fatalf("unexpected previous page: %v", p.Items.Items())
+	}
+}
+
+func TestCursorPaginate_RejectsWrongKeyType(t *testing.T) {
+	strs := New([]string{"a", "b"})
+	page, _ := CursorPaginate(strs, testSigner, "", 1, func(s string) string { return s })
+
+	_, err := CursorPaginate(New([]int{1, 2}), testSigner, page.NextCursor, 1, identity)
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestCursorPage_JSON(t *testing.T) {
+	page, _ := CursorPaginate(New([]int{1, 2}), testSigner, "", 1, identity)
+
+	out, err := json.Marshal(page)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		Data []int  `json:"data"`
+		Next string `json:"next_cursor"`
+		Prev string `json:"prev_cursor"`
+	}
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Data, []int{1}) || decoded.Next != page.NextCursor || decoded.Prev != "" {
+		t.Fatalf("unexpected JSON: %s", out)
+	}
+}
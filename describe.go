@@ -0,0 +1,117 @@
+package collection
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goforj/godump"
+)
+
+// Summary holds descriptive statistics for a NumericCollection, as returned
+// by Describe.
+//
+// StdDev is the population standard deviation. Percentiles use the
+// QuantileR7 method. Summary marshals to JSON with lower-case field names.
+type Summary[T Number] struct {
+	Count  int     `json:"count"`
+	Min    T       `json:"min"`
+	Max    T       `json:"max"`
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"stddev"`
+	P50    float64 `json:"p50"`
+	P90    float64 `json:"p90"`
+	P95    float64 `json:"p95"`
+	P99    float64 `json:"p99"`
+}
+
+// Describe computes count, min, max, mean, standard deviation and the
+// p50/p90/p95/p99 percentiles in a single report.
+// Returns (Summary{}, false) if the collection is empty.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Describe walks the items once for count, min, max, mean and standard
+// deviation, and sorts a single copy for all percentiles, instead of the
+// separate passes and copies made by calling Min, Max, Avg, StdDev and
+// Percentile individually. The borrowed slice is never mutated.
+//
+// Example: latency report
+//
+//	latencies := collection.NewNumeric([]int{120, 80, 95, 300, 110, 90, 105, 85, 100, 250})
+//	s, ok := latencies.Describe()
+//	fmt.Println(s, ok)
+//	// count=10 min=80 max=300 mean=133.5 stddev=72.49 p50=102.5 p90=255 p95=277.5 p99=295.5 true
+//
+// Example: JSON
+//
+//	s2, _ := collection.NewNumeric([]float64{1, 2, 3, 4}).Describe()
+//	out, _ := json.Marshal(s2)
+//	fmt.Println(string(out))
+//	// {"count":4,"min":1,"max":4,"mean":2.5,"stddev":1.118033988749895,"p50":2.5,"p90":3.7,"p95":3.8499999999999996,"p99":3.9699999999999998}
+//
+// Example: empty
+//
+//	_, ok3 := collection.NewNumeric([]int{}).Describe()
+//	fmt.Println(ok3)
+//	// false
+func (c *NumericCollection[T]) Describe() (Summary[T], bool) {
+	items := c.items
+	if len(items) == 0 {
+		return Summary[T]{}, false
+	}
+
+	m := welford(items)
+	s := Summary[T]{
+		Count:  m.n,
+		Min:    m.min,
+		Max:    m.max,
+		Mean:   m.mean,
+		StdDev: math.Sqrt(m.m2 / float64(m.n)),
+	}
+
+	sorted := sortedFloats(items)
+	s.P50 = quantile(sorted, 0.50, QuantileR7)
+	s.P90 = quantile(sorted, 0.90, QuantileR7)
+	s.P95 = quantile(sorted, 0.95, QuantileR7)
+	s.P99 = quantile(sorted, 0.99, QuantileR7)
+
+	return s, true
+}
+
+// String formats the summary on a single line, with floats rounded to
+// four significant digits.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: printing a summary
+//
+//	s, _ := collection.NewNumeric([]int{1, 2, 3}).Describe()
+//	fmt.Println(s.String())
+//	// count=3 min=1 max=3 mean=2 stddev=0.8165 p50=2 p90=2.8 p95=2.9 p99=2.98
+func (s Summary[T]) String() string {
+	return fmt.Sprintf(
+		"count=%d min=%v max=%v mean=%.4g stddev=%.4g p50=%.4g p90=%.4g p95=%.4g p99=%.4g",
+		s.Count, s.Min, s.Max, s.Mean, s.StdDev, s.P50, s.P90, s.P95, s.P99,
+	)
+}
+
+// Dump prints the summary with godump and returns it unchanged.
+// godump renders the summary through its String method.
+// @group Debugging
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: dumping a summary
+//
+//	s, _ := collection.NewNumeric([]int{2, 4, 6}).Describe()
+//	s.Dump()
+//	// count=3 min=2 max=6 mean=4 stddev=1.633 p50=4 p90=5.6 p95=5.8 p99=5.96 #collection.Summary[int]
+func (s Summary[T]) Dump() Summary[T] {
+	godump.Dump(s)
+	return s
+}
@@ -0,0 +1,82 @@
+package collection
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDescribe_MatchesIndividualStats(t *testing.T) {
+	c := NewNumeric([]float64{120, 80, 95, 300, 110, 90, 105, 85, 100, 250})
+
+	s, ok := c.Describe()
+	if !ok {
+		t.Fatalf("expected ok=true")
+	}
+
+	minV, _ := c.Min()
+	maxV, _ := c.Max()
+	sd, _ := c.StdDev()
+	ps, _ := c.Quantiles(0.5, 0.9, 0.95, 0.99)
+
+	if s.Count != 10 || s.Min != minV || s.Max != maxV {
+		t.Fatalf("unexpected count/min/max: %+v", s)
+	}
+	if math.Abs(s.Mean-c.Avg()) > 1e-9 || math.Abs(s.StdDev-sd) > 1e-9 {
+		t.Fatalf("unexpected mean/stddev: %+v", s)
+	}
+	if !reflect.DeepEqual([]float64{s.P50, s.P90, s.P95, s.P99}, ps) {
+		t.Fatalf("unexpected percentiles: %+v, want %v", s, ps)
+	}
+}
+
+func TestDescribe_Empty(t *testing.T) {
+	s, ok := NewNumeric([]int{}).Describe()
+
+	if ok || s != (Summary[int]{}) {
+		t.Fatalf("expected zero summary and ok=false, got %+v, %v", s, ok)
+	}
+}
+
+func TestDescribe_DoesNotMutate(t *testing.T) {
+	items := []int{3, 1, 2}
+
+	_, _ = NewNumeric(items).Describe()
+
+	if !reflect.DeepEqual(items, []int{3, 1, 2}) {
+		t.Fatalf("borrowed slice was mutated: %v", items)
+	}
+}
+
+func TestSummary_String(t *testing.T) {
+	s, _ := NewNumeric([]int{1, 2, 3}).Describe()
+
+	got := s.String()
+	if !strings.HasPrefix(got, "count=3 min=1 max=3 mean=2 ") {
+		t.Fatalf("unexpected String output: %q", got)
+	}
+}
+
+func TestSummary_JSON(t *testing.T) {
+	s, _ := NewNumeric([]int{5}).Describe()
+
+	out, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"count":1,"min":5,"max":5,"mean":5,"stddev":0,"p50":5,"p90":5,"p95":5,"p99":5}`
+	if string(out) != expected {
+		t.Fatalf("expected %s, got %s", expected, out)
+	}
+}
+
+func TestSummary_DumpReturnsSummary(t *testing.T) {
+	s, _ := NewNumeric([]int{1, 2}).Describe()
+
+	if got := s.Dump(); got != s {
+		t.Fatalf("Dump should return the summary unchanged")
+	}
+}
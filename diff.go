@@ -0,0 +1,32 @@
+package collection
+
+// Diff returns a new collection of differences between consecutive items.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Item i of the result is item i+1 minus item i, so the result has one item
+// fewer than the input. Collections with fewer than two items yield an
+// empty result. For unsigned types, a decrease wraps around.
+//
+// Example: daily deltas
+//
+//	totals := collection.NewNumeric([]int{100, 130, 125, 160})
+//	collection.Dump(totals.Diff().Items())
+//	// #[]int [
+//	//   0 => 30 #int
+//	//   1 => -5 #int
+//	//   2 => 35 #int
+//	// ]
+func (c *NumericCollection[T]) Diff() *NumericCollection[T] {
+	if len(c.items) < 2 {
+		return NewNumeric([]T{})
+	}
+
+	out := make([]T, len(c.items)-1)
+	for i := 1; i < len(c.items); i++ {
+		out[i-1] = c.items[i] - c.items[i-1]
+	}
+	return NewNumeric(out)
+}
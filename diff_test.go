@@ -0,0 +1,27 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff_ConsecutiveDifferences(t *testing.T) {
+	c := NewNumeric([]int{100, 130, 125, 160})
+
+	out := c.Diff()
+
+	if !reflect.DeepEqual(out.Items(), []int{30, -5, 35}) {
+		t.Fatalf("expected [30 -5 35], got %v", out.Items())
+	}
+	if !reflect.DeepEqual(c.Items(), []int{100, 130, 125, 160}) {
+		t.Fatalf("source was mutated: %v", c.Items())
+	}
+}
+
+func TestDiff_FewerThanTwoItems(t *testing.T) {
+	for _, items := range [][]int{{}, {7}} {
+		if got := NewNumeric(items).Diff().Items(); got == nil || len(got) != 0 {
+			t.Fatalf("expected empty non-nil result for %v, got %#v", items, got)
+		}
+	}
+}
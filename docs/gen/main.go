@@ -134,6 +134,7 @@ func extractFuncDocs(
 ) map[string]*FuncDoc {
 
 	out := map[string]*FuncDoc{}
+	methods := map[string]bool{}
 
 	for _, decl := range file.Decls {
 		fn, ok := decl.(*ast.FuncDecl)
@@ -141,19 +142,76 @@ func extractFuncDocs(
 			continue
 		}
 
-		name := fn.Name.Name
+		name := docName(fn)
+		examples := extractBlocks(fset, filename, name, fn)
+
+		// A Collection method and its NumericCollection counterpart share a
+		// name; keep the first description and merge examples. Any other
+		// collision (e.g. the Dump function and the Dump method) keeps the
+		// later declaration, as before.
+		if existing, ok := out[name]; ok && methods[name] && isCollectionMethod(fn) {
+			existing.Examples = append(existing.Examples, examples...)
+			continue
+		}
+		methods[name] = isCollectionMethod(fn)
 
 		out[name] = &FuncDoc{
 			Name:        name,
 			Group:       extractGroup(fn.Doc),
 			Description: extractFuncDescription(fn.Doc),
-			Examples:    extractBlocks(fset, filename, name, fn),
+			Examples:    examples,
 		}
 	}
 
 	return out
 }
 
+// docName returns the documented name for fn. Methods on types other than
+// Collection and NumericCollection are qualified with their receiver so
+// that, for example, LazyCollection.Filter does not collide with Filter.
+func docName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	switch recv := receiverName(fn.Recv.List[0].Type); recv {
+	case "", "Collection", "NumericCollection":
+		return fn.Name.Name
+	default:
+		return recv + "." + fn.Name.Name
+	}
+}
+
+// isCollectionMethod reports whether fn is a method on Collection or
+// NumericCollection.
+func isCollectionMethod(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return false
+	}
+	recv := receiverName(fn.Recv.List[0].Type)
+	return recv == "Collection" || recv == "NumericCollection"
+}
+
+func receiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(t.X)
+	case *ast.IndexExpr:
+		return receiverName(t.X)
+	case *ast.IndexListExpr:
+		return receiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	default:
+		return ""
+	}
+}
+
+// slug turns a documented name into a directory / anchor name.
+func slug(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, ".", ""))
+}
+
 func extractGroup(group *ast.CommentGroup) string {
 	lines := docLines(group)
 
@@ -278,12 +336,34 @@ func extractBlocks(
 // ------------------------------------------------------------
 //
 
+// stdImports lists the standard library packages examples may reference
+// without importing them explicitly in the doc block.
+var stdImports = []struct {
+	pattern *regexp.Regexp
+	path    string
+}{
+	{regexp.MustCompile(`\bfmt\.`), "fmt"},
+	{regexp.MustCompile(`\bstrings\.`), "strings"},
+	{regexp.MustCompile(`\bslices\.`), "slices"},
+	{regexp.MustCompile(`\bmaps\.`), "maps"},
+	{regexp.MustCompile(`\batomic\.`), "sync/atomic"},
+	{regexp.MustCompile(`\berrors\.`), "errors"},
+	{regexp.MustCompile(`\bstrconv\.`), "strconv"},
+	{regexp.MustCompile(`\bcontext\.`), "context"},
+	{regexp.MustCompile(`\bcmp\.`), "cmp"},
+	{regexp.MustCompile(`\btime\.`), "time"},
+	{regexp.MustCompile(`\bjson\.`), "encoding/json"},
+	{regexp.MustCompile(`\bcsv\.`), "encoding/csv"},
+	{regexp.MustCompile(`\bos\.`), "os"},
+	{regexp.MustCompile(`\bflag\.`), "flag"},
+}
+
 func writeMain(base string, fd *FuncDoc) error {
 	if len(fd.Examples) == 0 {
 		return nil
 	}
 
-	dir := filepath.Join(base, strings.ToLower(fd.Name))
+	dir := filepath.Join(base, slug(fd.Name))
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return err
 	}
@@ -301,11 +381,10 @@ func writeMain(base string, fd *FuncDoc) error {
 	}
 
 	for _, ex := range fd.Examples {
-		if strings.Contains(ex.Code, "fmt.") {
-			imports["fmt"] = true
-		}
-		if strings.Contains(ex.Code, "strings.") {
-			imports["strings"] = true
+		for _, imp := range stdImports {
+			if imp.pattern.MatchString(ex.Code) {
+				imports[imp.path] = true
+			}
 		}
 	}
 
@@ -145,7 +145,7 @@ func parseFuncs(root string) ([]*FuncDoc, error) {
 			}
 
 			fd := &FuncDoc{
-				Name:        fn.Name.Name,
+				Name:        docName(fn),
 				Group:       extractGroup(fn.Doc),
 				Behavior:    extractBehavior(fn.Doc),
 				Chainable:   extractChainable(fn.Doc),
@@ -173,6 +173,42 @@ func parseFuncs(root string) ([]*FuncDoc, error) {
 	return out, nil
 }
 
+// docName returns the documented name for fn. Methods on types other than
+// Collection and NumericCollection are qualified with their receiver so
+// that, for example, LazyCollection.Filter does not collide with Filter.
+func docName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	switch recv := receiverName(fn.Recv.List[0].Type); recv {
+	case "", "Collection", "NumericCollection":
+		return fn.Name.Name
+	default:
+		return recv + "." + fn.Name.Name
+	}
+}
+
+func receiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(t.X)
+	case *ast.IndexExpr:
+		return receiverName(t.X)
+	case *ast.IndexListExpr:
+		return receiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	default:
+		return ""
+	}
+}
+
+// slug turns a documented name into a directory / anchor name.
+func slug(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, ".", ""))
+}
+
 func extractGroup(group *ast.CommentGroup) string {
 	for _, c := range group.List {
 		line := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
@@ -361,7 +397,7 @@ func renderAPI(funcs []*FuncDoc) string {
 
 		var links []string
 		for _, fn := range byGroup[group] {
-			links = append(links, fmt.Sprintf("[%s](#%s)", fn.Name, strings.ToLower(fn.Name)))
+			links = append(links, fmt.Sprintf("[%s](#%s)", fn.Name, slug(fn.Name)))
 		}
 
 		buf.WriteString(fmt.Sprintf("| **%s** | %s |\n",
@@ -377,7 +413,7 @@ func renderAPI(funcs []*FuncDoc) string {
 		buf.WriteString("## " + group + "\n\n")
 
 		for _, fn := range byGroup[group] {
-			anchor := strings.ToLower(fn.Name)
+			anchor := slug(fn.Name)
 
 			header := fn.Name
 			if fn.Behavior != "" {
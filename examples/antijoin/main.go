@@ -0,0 +1,26 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// AntiJoin returns a new collection with the items in a that have no match
+	// in b.
+
+	// Example: users who never logged in
+	type Login struct{ UserID int }
+
+	users := collection.New([]int{1, 2, 3})
+	logins := collection.New([]Login{{UserID: 3}, {UserID: 1}})
+
+	inactive := collection.AntiJoin(users, logins,
+		func(id int) int { return id },
+		func(l Login) int { return l.UserID },
+	)
+	collection.Dump(inactive.Items())
+	// #[]int [
+	//   0 => 2 #int
+	// ]
+}
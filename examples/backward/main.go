@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Backward returns an iterator over index/value pairs, traversing the
+	// collection from the last item to the first.
+
+	// Example: integers
+	c := collection.New([]int{10, 20, 30})
+	for i, v := range c.Backward() {
+		fmt.Println(i, v)
+	}
+	// 2 30
+	// 1 20
+	// 0 10
+}
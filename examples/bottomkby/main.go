@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// BottomKBy returns a new collection with the k items whose keys are
+	// smallest, lowest first.
+
+	// Example: strings - two shortest
+	words := collection.New([]string{"collection", "go", "forj", "io"})
+	short := collection.BottomKBy(words, 2, func(s string) int { return len(s) })
+	collection.Dump(short.Items())
+	// #[]string [
+	//   0 => "go" #string
+	//   1 => "io" #string
+	// ]
+}
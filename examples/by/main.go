@@ -0,0 +1,47 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// By returns a Comparator ordering T ascending by the key returned from keyFn.
+
+	// Example: sort by region, then by errors descending
+	type Event struct {
+		Region string
+		Errors int
+	}
+
+	events := collection.New([]Event{
+		{Region: "us-west", Errors: 3},
+		{Region: "us-east", Errors: 1},
+		{Region: "us-west", Errors: 9},
+		{Region: "us-east", Errors: 7},
+	})
+
+	order := collection.By(func(e Event) string { return e.Region }).
+		ThenDesc(collection.By(func(e Event) int { return e.Errors }))
+
+	events.SortWith(order.Compare)
+	collection.Dump(events.Items())
+	// #[]main.Event [
+	//   0 => #main.Event {
+	//     +Region => "us-east" #string
+	//     +Errors => 7 #int
+	//   }
+	//   1 => #main.Event {
+	//     +Region => "us-east" #string
+	//     +Errors => 1 #int
+	//   }
+	//   2 => #main.Event {
+	//     +Region => "us-west" #string
+	//     +Errors => 9 #int
+	//   }
+	//   3 => #main.Event {
+	//     +Region => "us-west" #string
+	//     +Errors => 3 #int
+	//   }
+	// ]
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ByDesc returns a Comparator ordering T descending by the key returned from keyFn.
+
+	// Example: strings by length, longest first
+	words := collection.New([]string{"go", "collection", "forj"})
+	words.SortWith(collection.ByDesc(func(s string) int { return len(s) }).Compare)
+	collection.Dump(words.Items())
+	// #[]string [
+	//   0 => "collection" #string
+	//   1 => "forj" #string
+	//   2 => "go" #string
+	// ]
+}
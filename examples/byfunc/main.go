@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"strings"
+)
+
+func main() {
+	// ByFunc returns a Comparator ordering T by the key returned from keyFn,
+	// using compare to order keys.
+
+	// Example: case-insensitive names
+	names := collection.New([]string{"bob", "Alice", "carol"})
+	names.SortWith(collection.ByFunc(strings.ToLower, strings.Compare).Compare)
+	collection.Dump(names.Items())
+	// #[]string [
+	//   0 => "Alice" #string
+	//   1 => "bob" #string
+	//   2 => "carol" #string
+	// ]
+}
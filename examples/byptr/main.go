@@ -0,0 +1,35 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// ByPtr returns a Comparator ordering T ascending by a pointer key, where a
+	// nil pointer represents a missing value.
+
+	// Example: missing scores last
+	type Player struct {
+		Name  string
+		Score *int
+	}
+
+	five, nine := 5, 9
+	players := collection.New([]Player{
+		{Name: "nil"},
+		{Name: "nine", Score: &nine},
+		{Name: "five", Score: &five},
+	})
+
+	players.SortWith(collection.ByPtr(func(p Player) *int { return p.Score }).NilsLast().Compare)
+	for _, p := range players.Items() {
+		fmt.Println(p.Name)
+	}
+	// five
+	// nine
+	// nil
+}
@@ -0,0 +1,30 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+	"time"
+)
+
+func main() {
+	// ByTime returns a Comparator ordering T ascending by a time.Time key.
+
+	// Example: oldest first
+	type Job struct {
+		Name string
+		At   time.Time
+	}
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobs := collection.New([]Job{
+		{Name: "b", At: base.Add(time.Hour)},
+		{Name: "a", At: base},
+	})
+
+	jobs.SortWith(collection.ByTime(func(j Job) time.Time { return j.At }).Compare)
+	fmt.Println(jobs.Items()[0].Name, jobs.Items()[1].Name)
+	// a b
+}
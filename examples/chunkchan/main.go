@@ -0,0 +1,32 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// ChunkChan groups values from in into slices of the given size and streams
+	// each chunk into the returned channel.
+
+	// Example: integers
+	in := collection.New([]int{1, 2, 3, 4, 5}).ToChan(0)
+	chunks := collection.ChunkChan(context.Background(), in, 2)
+	collection.Dump(collection.FromChan(chunks).Items())
+	// #[][]int [
+	//   0 => #[]int [
+	//     0 => 1 #int
+	//     1 => 2 #int
+	//   ]
+	//   1 => #[]int [
+	//     0 => 3 #int
+	//     1 => 4 #int
+	//   ]
+	//   2 => #[]int [
+	//     0 => 5 #int
+	//   ]
+	// ]
+}
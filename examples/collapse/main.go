@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Collapse concatenates a collection of collections into a single collection.
+
+	// Example: integers
+	batches := collection.New([]*collection.Collection[int]{
+		collection.New([]int{1, 2}),
+		nil,
+		collection.New([]int{3}),
+	})
+	all := collection.Collapse(batches)
+	collection.Dump(all.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
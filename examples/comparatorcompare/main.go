@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Compare returns a negative number when a orders before b, a positive
+	// number when a orders after b, and zero when they are equal on every key.
+
+	// Example: direct comparison
+	byLen := collection.By(func(s string) int { return len(s) })
+	fmt.Println(byLen.Compare("go", "forj"))
+	// -1
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Less reports whether a orders strictly before b.
+
+	// Example: with Sort
+	nums := collection.New([]int{3, 1, 2})
+	nums.Sort(collection.By(func(v int) int { return v }).Less)
+	collection.Dump(nums.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
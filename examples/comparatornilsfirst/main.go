@@ -0,0 +1,31 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// NilsFirst returns a Comparator that places nil keys of the most recently
+	// added nil-aware key (see ByPtr) before all other values, in either direction.
+
+	// Example: missing scores first, even when descending
+	type Player struct {
+		Name  string
+		Score *int
+	}
+
+	five := 5
+	players := collection.New([]Player{
+		{Name: "five", Score: &five},
+		{Name: "nil"},
+	})
+
+	order := collection.ByPtr(func(p Player) *int { return p.Score }).Reverse().NilsFirst()
+	players.SortWith(order.Compare)
+	fmt.Println(players.Items()[0].Name)
+	// nil
+}
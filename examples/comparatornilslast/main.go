@@ -0,0 +1,30 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// NilsLast returns a Comparator that places nil keys of the most recently
+	// added nil-aware key (see ByPtr) after all other values, in either direction.
+
+	// Example: missing scores last
+	type Player struct {
+		Name  string
+		Score *int
+	}
+
+	five := 5
+	players := collection.New([]Player{
+		{Name: "nil"},
+		{Name: "five", Score: &five},
+	})
+
+	players.SortWith(collection.ByPtr(func(p Player) *int { return p.Score }).NilsLast().Compare)
+	fmt.Println(players.Items()[1].Name)
+	// nil
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Reverse returns a Comparator with every key's direction flipped.
+
+	// Example: integers descending
+	nums := collection.New([]int{2, 3, 1})
+	nums.SortWith(collection.By(func(v int) int { return v }).Reverse().Compare)
+	collection.Dump(nums.Items())
+	// #[]int [
+	//   0 => 3 #int
+	//   1 => 2 #int
+	//   2 => 1 #int
+	// ]
+}
@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Then returns a Comparator that breaks ties in c using next.
+
+	// Example: by length, then alphabetically
+	words := collection.New([]string{"bb", "a", "ab", "c"})
+	order := collection.By(func(s string) int { return len(s) }).
+		Then(collection.By(func(s string) string { return s }))
+	words.SortWith(order.Compare)
+	collection.Dump(words.Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "c" #string
+	//   2 => "ab" #string
+	//   3 => "bb" #string
+	// ]
+}
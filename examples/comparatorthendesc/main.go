@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ThenDesc returns a Comparator that breaks ties in c using next in
+	// reverse order.
+
+	// Example: by length, then reverse alphabetically
+	words := collection.New([]string{"a", "bb", "ab", "c"})
+	order := collection.By(func(s string) int { return len(s) }).
+		ThenDesc(collection.By(func(s string) string { return s }))
+	words.SortWith(order.Compare)
+	collection.Dump(words.Items())
+	// #[]string [
+	//   0 => "c" #string
+	//   1 => "a" #string
+	//   2 => "bb" #string
+	//   3 => "ab" #string
+	// ]
+}
@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// CumMax returns a new collection of running maxima.
+
+	// Example: high-water mark
+	c := collection.NewNumeric([]int{3, 1, 4, 1, 5})
+	collection.Dump(c.CumMax().Items())
+	// #[]int [
+	//   0 => 3 #int
+	//   1 => 3 #int
+	//   2 => 4 #int
+	//   3 => 4 #int
+	//   4 => 5 #int
+	// ]
+}
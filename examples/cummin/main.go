@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// CumMin returns a new collection of running minima.
+
+	// Example: low-water mark
+	c := collection.NewNumeric([]int{3, 4, 1, 5, 0})
+	collection.Dump(c.CumMin().Items())
+	// #[]int [
+	//   0 => 3 #int
+	//   1 => 3 #int
+	//   2 => 1 #int
+	//   3 => 1 #int
+	//   4 => 0 #int
+	// ]
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// CumProd returns a new collection of running products.
+
+	// Example: compounding growth factors
+	growth := collection.NewNumeric([]float64{1.1, 1.2, 0.5})
+	collection.Dump(growth.CumProd().Items())
+	// #[]float64 [
+	//   0 => 1.100000 #float64
+	//   1 => 1.320000 #float64
+	//   2 => 0.660000 #float64
+	// ]
+}
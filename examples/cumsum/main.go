@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// CumSum returns a new collection of running totals.
+
+	// Example: cumulative revenue
+	revenue := collection.NewNumeric([]int{10, 20, 5, 15})
+	collection.Dump(revenue.CumSum().Items())
+	// #[]int [
+	//   0 => 10 #int
+	//   1 => 30 #int
+	//   2 => 35 #int
+	//   3 => 50 #int
+	// ]
+}
@@ -0,0 +1,67 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// CursorPaginate returns up to limit items following (or preceding) the
+	// position encoded in cursor, using keyset pagination over keyFn.
+
+	// Example: walking a feed
+	type Post struct {
+		ID    int
+		Title string
+	}
+
+	posts := collection.New([]Post{
+		{ID: 1, Title: "one"},
+		{ID: 2, Title: "two"},
+		{ID: 3, Title: "three"},
+		{ID: 4, Title: "four"},
+		{ID: 5, Title: "five"},
+	})
+	byID := func(p Post) int { return p.ID }
+
+	signer := collection.NewCursorSigner([]byte("0123456789abcdef0123456789abcdef"))
+	first, _ := collection.CursorPaginate(posts, signer, "", 2, byID)
+	second, _ := collection.CursorPaginate(posts, signer, first.NextCursor, 2, byID)
+	collection.Dump(second.Items.Items())
+	// #[]main.Post [
+	//   0 => #main.Post {
+	//     +ID    => 3 #int
+	//     +Title => "three" #string
+	//   }
+	//   1 => #main.Post {
+	//     +ID    => 4 #int
+	//     +Title => "four" #string
+	//   }
+	// ]
+
+	back, _ := collection.CursorPaginate(posts, signer, second.PrevCursor, 2, byID)
+	collection.Dump(back.Items.Items())
+	// #[]main.Post [
+	//   0 => #main.Post {
+	//     +ID    => 1 #int
+	//     +Title => "one" #string
+	//   }
+	//   1 => #main.Post {
+	//     +ID    => 2 #int
+	//     +Title => "two" #string
+	//   }
+	// ]
+	fmt.Println(back.PrevCursor == "", back.NextCursor != "")
+	// true true
+
+	// Example: rejecting a modified cursor
+	key := collection.NewCursorSigner([]byte("0123456789abcdef0123456789abcdef"))
+	ids := collection.New([]int{10, 20, 30})
+	page, _ := collection.CursorPaginate(ids, key, "", 1, func(v int) int { return v })
+	_, err := collection.CursorPaginate(ids, key, page.NextCursor+"x", 1, func(v int) int { return v })
+	fmt.Println(err)
+	// collection: invalid cursor
+}
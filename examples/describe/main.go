@@ -0,0 +1,33 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Describe computes count, min, max, mean, standard deviation and the
+	// p50/p90/p95/p99 percentiles in a single report.
+	// Returns (Summary{}, false) if the collection is empty.
+
+	// Example: latency report
+	latencies := collection.NewNumeric([]int{120, 80, 95, 300, 110, 90, 105, 85, 100, 250})
+	s, ok := latencies.Describe()
+	fmt.Println(s, ok)
+	// count=10 min=80 max=300 mean=133.5 stddev=72.49 p50=102.5 p90=255 p95=277.5 p99=295.5 true
+
+	// Example: JSON
+	s2, _ := collection.NewNumeric([]float64{1, 2, 3, 4}).Describe()
+	out, _ := json.Marshal(s2)
+	fmt.Println(string(out))
+	// {"count":4,"min":1,"max":4,"mean":2.5,"stddev":1.118033988749895,"p50":2.5,"p90":3.7,"p95":3.8499999999999996,"p99":3.9699999999999998}
+
+	// Example: empty
+	_, ok3 := collection.NewNumeric([]int{}).Describe()
+	fmt.Println(ok3)
+	// false
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Diff returns a new collection of differences between consecutive items.
+
+	// Example: daily deltas
+	totals := collection.NewNumeric([]int{100, 130, 125, 160})
+	collection.Dump(totals.Diff().Items())
+	// #[]int [
+	//   0 => 30 #int
+	//   1 => -5 #int
+	//   2 => 35 #int
+	// ]
+}
@@ -0,0 +1,38 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// EachCtx runs fn for every item, checking ctx for cancellation before each one.
+
+	// Example: integers
+	ctx := context.Background()
+	err := collection.New([]int{1, 2, 3}).EachCtx(ctx, func(ctx context.Context, v int) {
+		fmt.Println(v)
+	})
+	fmt.Println(err)
+	// 1
+	// 2
+	// 3
+	// <nil>
+
+	// Example: cancellation stops iteration
+	ctx2, cancel := context.WithCancel(context.Background())
+	err2 := collection.New([]int{1, 2, 3}).EachCtx(ctx2, func(ctx context.Context, v int) {
+		fmt.Println(v)
+		if v == 2 {
+			cancel()
+		}
+	})
+	fmt.Println(err2)
+	// 1
+	// 2
+	// context canceled
+}
@@ -0,0 +1,32 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Entries returns an iterator over index/value pairs in the collection.
+
+	// Example: integers
+	c := collection.New([]int{10, 20, 30})
+	for i, v := range c.Entries() {
+		fmt.Println(i, v)
+	}
+	// 0 10
+	// 1 20
+	// 2 30
+
+	// Example: early exit
+	c2 := collection.New([]string{"a", "b", "c"})
+	for i, s := range c2.Entries() {
+		if i == 1 {
+			break
+		}
+		fmt.Println(i, s)
+	}
+	// 0 a
+}
@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// FilterChan streams the values from in for which fn returns true into the
+	// returned channel.
+
+	// Example: integers
+	in := collection.New([]int{1, 2, 3, 4}).ToChan(0)
+	evens := collection.FilterChan(context.Background(), in, func(v int) bool {
+		return v%2 == 0
+	})
+	collection.Dump(collection.FromChan(evens).Items())
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 4 #int
+	// ]
+}
@@ -0,0 +1,29 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// FilterCtx keeps only the elements for which fn returns true, checking ctx
+	// for cancellation before each item.
+	// This method mutates the collection in place and returns the same instance.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 3, 4})
+	_, err := c.FilterCtx(context.Background(), func(ctx context.Context, v int) bool {
+		return v%2 == 0
+	})
+	collection.Dump(c.Items())
+	fmt.Println(err)
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 4 #int
+	// ]
+	// <nil>
+}
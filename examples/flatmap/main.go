@@ -0,0 +1,47 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"strings"
+)
+
+func main() {
+	// FlatMap maps each item to a slice with fn and concatenates the results
+	// into a single Collection[R].
+
+	// Example: strings - split into words
+	lines := collection.New([]string{"go is fun", "", "ship it"})
+	words := collection.FlatMap(lines, strings.Fields)
+	collection.Dump(words.Items())
+	// #[]string [
+	//   0 => "go" #string
+	//   1 => "is" #string
+	//   2 => "fun" #string
+	//   3 => "ship" #string
+	//   4 => "it" #string
+	// ]
+
+	// Example: structs - expand line items
+	type Order struct {
+		ID    int
+		Items []string
+	}
+
+	orders := collection.New([]Order{
+		{ID: 1, Items: []string{"pen", "ink"}},
+		{ID: 2, Items: []string{"pad"}},
+	})
+
+	skus := collection.FlatMap(orders, func(o Order) []string {
+		return o.Items
+	})
+	collection.Dump(skus.Items())
+	// #[]string [
+	//   0 => "pen" #string
+	//   1 => "ink" #string
+	//   2 => "pad" #string
+	// ]
+}
@@ -0,0 +1,32 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Flatten concatenates a collection of slices into a single collection.
+
+	// Example: undo Chunk
+	c := collection.New([]int{1, 2, 3, 4, 5})
+	flat := collection.Flatten(collection.New(c.Chunk(2)))
+	collection.Dump(flat.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	//   3 => 4 #int
+	//   4 => 5 #int
+	// ]
+
+	// Example: strings
+	tags := collection.New([][]string{{"go", "api"}, nil, {"db"}})
+	flat2 := collection.Flatten(tags)
+	collection.Dump(flat2.Items())
+	// #[]string [
+	//   0 => "go" #string
+	//   1 => "api" #string
+	//   2 => "db" #string
+	// ]
+}
@@ -0,0 +1,40 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// FlattenDeep flattens nested slices and arrays up to depth levels, returning
+	// the leaves as a Collection[any].
+
+	// Example: unlimited depth
+	nested := collection.New([]any{1, []any{2, []int{3, 4}}, "five"})
+	flat := nested.FlattenDeep(0)
+	collection.Dump(flat.Items())
+	// #[]interface {} [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	//   3 => 4 #int
+	//   4 => "five" #string
+	// ]
+
+	// Example: one level
+	grid := collection.New([][][]int{{{1, 2}, {3}}, {{4}}})
+	rows := grid.FlattenDeep(1)
+	collection.Dump(rows.Items())
+	// #[]interface {} [
+	//   0 => #[]int [
+	//     0 => 1 #int
+	//     1 => 2 #int
+	//   ]
+	//   1 => #[]int [
+	//     0 => 3 #int
+	//   ]
+	//   2 => #[]int [
+	//     0 => 4 #int
+	//   ]
+	// ]
+}
@@ -0,0 +1,35 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ForPage returns the items that would appear on the given page number,
+	// where pages are 1-based and hold perPage items each.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	out := c.ForPage(2, 3)
+	collection.Dump(out.Items())
+	// #[]int [
+	//   0 => 4 #int
+	//   1 => 5 #int
+	//   2 => 6 #int
+	// ]
+
+	// Example: last partial page
+	out2 := c.ForPage(4, 2)
+	collection.Dump(out2.Items())
+	// #[]int [
+	//   0 => 7 #int
+	//   1 => 8 #int
+	// ]
+
+	// Example: past the end
+	out3 := c.ForPage(10, 3)
+	collection.Dump(out3.Items())
+	// #[]int [
+	// ]
+}
@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// FromChan creates a new Collection by draining ch until it is closed.
+
+	// Example: integers
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	c := collection.FromChan(ch)
+	collection.Dump(c.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
@@ -0,0 +1,30 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// FromChanCtx creates a new Collection by receiving from ch until it is
+	// closed, limit items have been received, or ctx is done.
+
+	// Example: take the first two items
+	ch := make(chan string, 3)
+	ch <- "a"
+	ch <- "b"
+	ch <- "c"
+
+	c, err := collection.FromChanCtx(context.Background(), ch, 2)
+	collection.Dump(c.Items())
+	fmt.Println(err)
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	// ]
+	// <nil>
+}
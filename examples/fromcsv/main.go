@@ -0,0 +1,49 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	// FromCSV reads comma-separated CSV from r into a new collection, using
+	// parseFn to turn each record into an item.
+
+	// Example: parsing rows
+	type Txn struct {
+		Account string
+		Amount  int
+	}
+
+	input := "account,amount\nacme,120\nglobex,80\n"
+
+	txns, err := collection.FromCSV(strings.NewReader(input), true, func(rec []string) (Txn, error) {
+		n, err := strconv.Atoi(rec[1])
+		return Txn{Account: rec[0], Amount: n}, err
+	})
+	collection.Dump(txns.Items())
+	fmt.Println(err)
+	// #[]main.Txn [
+	//   0 => #main.Txn {
+	//     +Account => "acme" #string
+	//     +Amount  => 120 #int
+	//   }
+	//   1 => #main.Txn {
+	//     +Account => "globex" #string
+	//     +Amount  => 80 #int
+	//   }
+	// ]
+	// <nil>
+
+	// Example: error with line number
+	_, err2 := collection.FromCSV(strings.NewReader("a\n1\nx\n"), true, func(rec []string) (int, error) {
+		return strconv.Atoi(rec[0])
+	})
+	fmt.Println(err2)
+	// line 3: strconv.Atoi: parsing "x": invalid syntax
+}
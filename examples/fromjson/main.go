@@ -0,0 +1,35 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+	"strings"
+)
+
+func main() {
+	// FromJSON decodes a top-level JSON array from r into a new collection.
+
+	// Example: loading an array
+	type User struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	users, err := collection.FromJSON[User](strings.NewReader(`[{"id":1,"name":"ann"},{"id":2,"name":"bob"}]`))
+	collection.Dump(users.Items())
+	fmt.Println(err)
+	// #[]main.User [
+	//   0 => #main.User {
+	//     +ID   => 1 #int
+	//     +Name => "ann" #string
+	//   }
+	//   1 => #main.User {
+	//     +ID   => 2 #int
+	//     +Name => "bob" #string
+	//   }
+	// ]
+	// <nil>
+}
@@ -0,0 +1,36 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+	"strings"
+)
+
+func main() {
+	// FromNDJSON reads newline-delimited JSON from r into a new collection.
+
+	// Example: loading records
+	type Event struct {
+		ID   int    `json:"id"`
+		Kind string `json:"kind"`
+	}
+
+	input := "{\"id\":1,\"kind\":\"login\"}\n{\"id\":2,\"kind\":\"logout\"}\n"
+	events, err := collection.FromNDJSON[Event](strings.NewReader(input))
+	collection.Dump(events.Items())
+	fmt.Println(err)
+	// #[]main.Event [
+	//   0 => #main.Event {
+	//     +ID   => 1 #int
+	//     +Kind => "login" #string
+	//   }
+	//   1 => #main.Event {
+	//     +ID   => 2 #int
+	//     +Kind => "logout" #string
+	//   }
+	// ]
+	// <nil>
+}
@@ -0,0 +1,34 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"maps"
+	"slices"
+)
+
+func main() {
+	// FromSeq creates a new Collection by draining the provided iterator.
+
+	// Example: integers
+	c := collection.FromSeq(slices.Values([]int{1, 2, 3}))
+	collection.Dump(c.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+
+	// Example: map keys
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+	keys := collection.FromSeq(maps.Keys(m)).
+		Sort(func(a, b string) bool { return a < b })
+	collection.Dump(keys.Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	//   2 => "c" #string
+	// ]
+}
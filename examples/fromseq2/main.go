@@ -0,0 +1,43 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"slices"
+)
+
+func main() {
+	// FromSeq2 creates a new Collection of key/value pairs by draining the
+	// provided two-value iterator.
+
+	// Example: index/value pairs
+	c := collection.FromSeq2(slices.All([]string{"a", "b"}))
+	collection.Dump(c.Items())
+	// #[]collection.Pair[int,string] [
+	//   0 => #collection.Pair[int,string] {
+	//     +Key   => 0 #int
+	//     +Value => "a" #string
+	//   }
+	//   1 => #collection.Pair[int,string] {
+	//     +Key   => 1 #int
+	//     +Value => "b" #string
+	//   }
+	// ]
+
+	// Example: round trip through another collection
+	src := collection.New([]int{10, 20})
+	pairs := collection.FromSeq2(src.Backward())
+	collection.Dump(pairs.Items())
+	// #[]collection.Pair[int,int] [
+	//   0 => #collection.Pair[int,int] {
+	//     +Key   => 1 #int
+	//     +Value => 20 #int
+	//   }
+	//   1 => #collection.Pair[int,int] {
+	//     +Key   => 0 #int
+	//     +Value => 10 #int
+	//   }
+	// ]
+}
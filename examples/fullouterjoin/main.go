@@ -0,0 +1,33 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// FullOuterJoin pairs matching items from a and b, keeping unmatched items
+	// from both sides.
+
+	// Example: reconciling two inventories
+	left := collection.New([]string{"apple", "pear"})
+	right := collection.New([]string{"pear", "plum"})
+	id := func(s string) string { return s }
+
+	for _, r := range collection.FullOuterJoin(left, right, id, id).Items() {
+		l, rr := "-", "-"
+		if r.First != nil {
+			l = *r.First
+		}
+		if r.Second != nil {
+			rr = *r.Second
+		}
+		fmt.Println(l, rr)
+	}
+	// apple -
+	// pear pear
+	// - plum
+}
@@ -0,0 +1,31 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// GroupByCtx partitions the collection into groups keyed by keyFn, checking
+	// ctx for cancellation before each item.
+
+	// Example: grouping integers by parity
+	nums := collection.New([]int{1, 2, 3, 4})
+	groups, err := collection.GroupByCtx(context.Background(), nums, func(ctx context.Context, v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	collection.Dump(groups["even"].Items())
+	fmt.Println(err)
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 4 #int
+	// ]
+	// <nil>
+}
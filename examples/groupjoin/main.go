@@ -0,0 +1,37 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// GroupJoin pairs every item in a with all matching items from b.
+
+	// Example: customers with their orders
+	type Customer struct {
+		ID   int
+		Name string
+	}
+	type Order struct {
+		ID         int
+		CustomerID int
+	}
+
+	customers := collection.New([]Customer{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}})
+	orders := collection.New([]Order{{ID: 10, CustomerID: 1}, {ID: 11, CustomerID: 1}})
+
+	groups := collection.GroupJoin(customers, orders,
+		func(c Customer) int { return c.ID },
+		func(o Order) int { return o.CustomerID },
+	)
+
+	for _, g := range groups.Items() {
+		fmt.Println(g.First.Name, len(g.Second))
+	}
+	// Alice 2
+	// Bob 0
+}
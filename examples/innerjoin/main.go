@@ -0,0 +1,37 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// InnerJoin pairs every item in a with every item in b that has the same key.
+
+	// Example: orders with customers
+	type Customer struct {
+		ID   int
+		Name string
+	}
+	type Order struct {
+		ID         int
+		CustomerID int
+	}
+
+	customers := collection.New([]Customer{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}})
+	orders := collection.New([]Order{{ID: 10, CustomerID: 2}, {ID: 11, CustomerID: 1}, {ID: 12, CustomerID: 9}})
+
+	rows := collection.InnerJoin(orders, customers,
+		func(o Order) int { return o.CustomerID },
+		func(c Customer) int { return c.ID },
+	)
+
+	for _, r := range rows.Items() {
+		fmt.Println(r.First.ID, r.Second.Name)
+	}
+	// 10 Bob
+	// 11 Alice
+}
@@ -0,0 +1,32 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// InsertAt inserts the given values before index i, shifting later items
+	// to the right.
+
+	// Example: integers
+	c := collection.New([]int{1, 4})
+	c.InsertAt(1, 2, 3)
+	collection.Dump(c.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	//   3 => 4 #int
+	// ]
+
+	// Example: strings - index past the end appends
+	letters := collection.New([]string{"a", "b"})
+	letters.InsertAt(10, "c")
+	collection.Dump(letters.Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	//   2 => "c" #string
+	// ]
+}
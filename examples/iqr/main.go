@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// IQR returns the interquartile range of the collection, the difference
+	// between the 75th and 25th percentiles, using the QuantileR7 method.
+	// Returns (0, false) if the collection is empty.
+
+	// Example: integers
+	c := collection.NewNumeric([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	iqr, ok := c.IQR()
+	collection.Dump(iqr, ok)
+	// 4.000000 #float64
+	// true #bool
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// IsSorted reports whether the collection is sorted according to less.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 2, 5})
+	collection.Dump(c.IsSorted(func(a, b int) bool { return a < b }))
+	// true #bool
+
+	// Example: strings - descending check
+	c2 := collection.New([]string{"a", "c", "b"})
+	collection.Dump(c2.IsSorted(func(a, b string) bool { return a > b }))
+	// false #bool
+}
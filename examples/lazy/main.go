@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Lazy returns a LazyCollection over the collection's items.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 3, 4})
+	first, ok := c.Lazy().
+		Filter(func(v int) bool { return v > 2 }).
+		First()
+	collection.Dump(first, ok)
+	// 3 #int
+	// true #bool
+}
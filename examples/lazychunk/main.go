@@ -0,0 +1,26 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// LazyChunk adds a deferred stage that groups items into slices of the given size.
+	// The final chunk may be smaller. If size <= 0, nothing is yielded.
+
+	// Example: integers
+	lc := collection.LazyGenerate(func(i int) int { return i + 1 })
+	chunks := collection.LazyChunk(lc, 2).Take(2).Collect()
+	collection.Dump(chunks.Items())
+	// #[][]int [
+	//   0 => #[]int [
+	//     0 => 1 #int
+	//     1 => 2 #int
+	//   ]
+	//   1 => #[]int [
+	//     0 => 3 #int
+	//     1 => 4 #int
+	//   ]
+	// ]
+}
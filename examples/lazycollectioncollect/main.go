@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Collect runs the pipeline and materializes the results into a Collection.
+
+	// Example: integers
+	out := collection.NewLazy([]int{1, 2, 3}).
+		Map(func(v int) int { return v + 1 }).
+		Collect()
+	collection.Dump(out.Items())
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 3 #int
+	//   2 => 4 #int
+	// ]
+}
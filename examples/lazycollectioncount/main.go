@@ -0,0 +1,17 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Count runs the pipeline and returns the number of items it yields.
+
+	// Example: integers
+	n := collection.NewLazy([]int{1, 2, 3, 4, 5}).
+		Filter(func(v int) bool { return v > 2 }).
+		Count()
+	collection.Dump(n)
+	// 3 #int
+}
@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Each runs the pipeline and calls fn for every item it yields.
+
+	// Example: integers
+	collection.NewLazy([]int{1, 2, 3}).
+		Map(func(v int) int { return v * v }).
+		Each(func(v int) {
+			fmt.Println(v)
+		})
+	// 1
+	// 4
+	// 9
+}
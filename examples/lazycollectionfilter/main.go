@@ -0,0 +1,26 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Filter adds a deferred stage that keeps only items for which fn returns true.
+
+	// Example: integers
+	calls := 0
+	out := collection.NewLazy([]int{1, 2, 3, 4, 5, 6, 7, 8}).
+		Filter(func(v int) bool {
+			calls++
+			return v%2 == 0
+		}).
+		Take(2).
+		Collect()
+	collection.Dump(out.Items(), calls)
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 4 #int
+	// ]
+	// 4 #int
+}
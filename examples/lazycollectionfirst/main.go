@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// First runs the pipeline until it yields one item and returns it.
+	// If the pipeline is empty, ok will be false.
+
+	// Example: integers
+	v, ok := collection.LazyGenerate(func(i int) int { return i * 3 }).
+		Filter(func(v int) bool { return v > 10 }).
+		First()
+	collection.Dump(v, ok)
+	// 12 #int
+	// true #bool
+
+	// Example: empty pipeline
+	v2, ok2 := collection.NewLazy([]int{}).First()
+	collection.Dump(v2, ok2)
+	// 0 #int
+	// false #bool
+}
@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Map adds a deferred same-type transformation stage.
+
+	// Example: integers
+	out := collection.NewLazy([]int{1, 2, 3}).
+		Map(func(v int) int { return v * 2 }).
+		Collect()
+	collection.Dump(out.Items())
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 4 #int
+	//   2 => 6 #int
+	// ]
+}
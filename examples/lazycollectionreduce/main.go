@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Reduce runs the pipeline and collapses it into a single accumulated value.
+
+	// Example: integers - sum of the first four odd numbers
+	sum := collection.LazyGenerate(func(i int) int { return i }).
+		Filter(func(v int) bool { return v%2 == 1 }).
+		Take(4).
+		Reduce(0, func(acc, v int) int { return acc + v })
+	collection.Dump(sum)
+	// 16 #int
+}
@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Skip adds a deferred stage that drops the first n items.
+	// If n is less than or equal to zero, every item passes through.
+
+	// Example: integers
+	out := collection.NewLazy([]int{1, 2, 3, 4, 5}).
+		Skip(3).
+		Collect()
+	collection.Dump(out.Items())
+	// #[]int [
+	//   0 => 4 #int
+	//   1 => 5 #int
+	// ]
+}
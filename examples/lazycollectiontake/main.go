@@ -0,0 +1,32 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Take adds a deferred stage that yields the first n items when n > 0,
+	// or the last |n| items when n < 0.
+
+	// Example: integers - first 3
+	out := collection.LazyGenerate(func(i int) int { return i + 1 }).
+		Take(3).
+		Collect()
+	collection.Dump(out.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+
+	// Example: integers - last 2 (negative n)
+	out2 := collection.NewLazy([]int{1, 2, 3, 4, 5}).
+		Take(-2).
+		Collect()
+	collection.Dump(out2.Items())
+	// #[]int [
+	//   0 => 4 #int
+	//   1 => 5 #int
+	// ]
+}
@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// TakeUntilFn adds a deferred stage that yields items until pred returns true.
+	// The matching item is NOT included, and the source is not read further.
+
+	// Example: integers - stop at first value >= 3
+	out := collection.LazyGenerate(func(i int) int { return i }).
+		TakeUntilFn(func(v int) bool { return v >= 3 }).
+		Collect()
+	collection.Dump(out.Items())
+	// #[]int [
+	//   0 => 0 #int
+	//   1 => 1 #int
+	//   2 => 2 #int
+	// ]
+}
@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Values returns the pipeline as an iterator.
+
+	// Example: integers
+	lc := collection.NewLazy([]int{1, 2, 3}).
+		Map(func(v int) int { return v * 10 })
+	for v := range lc.Values() {
+		fmt.Println(v)
+	}
+	// 10
+	// 20
+	// 30
+}
@@ -0,0 +1,33 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"slices"
+)
+
+func main() {
+	// LazyFromSeq creates a LazyCollection over the provided iterator.
+
+	// Example: generator function
+	lc := collection.LazyFromSeq(func(yield func(string) bool) {
+		for _, s := range []string{"a", "b", "c"} {
+			if !yield(s) {
+				return
+			}
+		}
+	})
+	collection.Dump(lc.Collect().Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	//   2 => "c" #string
+	// ]
+
+	// Example: stdlib iterator
+	lc2 := collection.LazyFromSeq(slices.Values([]int{3, 1, 2}))
+	collection.Dump(lc2.Count())
+	// 3 #int
+}
@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// LazyGenerate creates an infinite LazyCollection by calling fn(i) for
+	// i = 0, 1, 2, ...
+
+	// Example: first five squares
+	squares := collection.LazyGenerate(func(i int) int { return i * i }).
+		Take(5).
+		Collect()
+	collection.Dump(squares.Items())
+	// #[]int [
+	//   0 => 0 #int
+	//   1 => 1 #int
+	//   2 => 4 #int
+	//   3 => 9 #int
+	//   4 => 16 #int
+	// ]
+}
@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// LazyMapTo adds a deferred stage that maps each item from T to R.
+
+	// Example: integers to strings
+	lc := collection.NewLazy([]int{1, 2, 3})
+	labels := collection.LazyMapTo(lc, func(v int) string {
+		return fmt.Sprintf("#%d", v)
+	}).Collect()
+	collection.Dump(labels.Items())
+	// #[]string [
+	//   0 => "#1" #string
+	//   1 => "#2" #string
+	//   2 => "#3" #string
+	// ]
+}
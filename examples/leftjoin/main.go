@@ -0,0 +1,42 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// LeftJoin pairs every item in a with every matching item in b, keeping items
+	// in a that have no match.
+
+	// Example: devices with optional owners
+	type Device struct {
+		Name    string
+		OwnerID int
+	}
+	type Owner struct {
+		ID   int
+		Name string
+	}
+
+	devices := collection.New([]Device{{Name: "r1", OwnerID: 1}, {Name: "r2", OwnerID: 5}})
+	owners := collection.New([]Owner{{ID: 1, Name: "ops"}})
+
+	rows := collection.LeftJoin(devices, owners,
+		func(d Device) int { return d.OwnerID },
+		func(o Owner) int { return o.ID },
+	)
+
+	for _, r := range rows.Items() {
+		owner := "<none>"
+		if r.Second != nil {
+			owner = r.Second.Name
+		}
+		fmt.Println(r.First.Name, owner)
+	}
+	// r1 ops
+	// r2 <none>
+}
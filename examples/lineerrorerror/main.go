@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Error formats the error with its line number.
+
+	// Example: formatting a line error
+	err := &collection.LineError{Line: 3, Err: errors.New("bad amount")}
+	fmt.Println(err.Error())
+	// line 3: bad amount
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Unwrap returns the underlying error, so errors.Is and errors.As see it.
+
+	// Example: matching the cause
+	cause := errors.New("bad amount")
+	err := &collection.LineError{Line: 3, Err: cause}
+	fmt.Println(errors.Is(err, cause))
+	// true
+}
@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// MapToChan streams fn(v) for every value v from in into the returned channel.
+
+	// Example: integers to strings
+	in := collection.New([]int{1, 2}).ToChan(0)
+	labels := collection.MapToChan(context.Background(), in, func(v int) string {
+		return fmt.Sprintf("#%d", v)
+	})
+	collection.Dump(collection.FromChan(labels).Items())
+	// #[]string [
+	//   0 => "#1" #string
+	//   1 => "#2" #string
+	// ]
+}
@@ -0,0 +1,29 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// MapToCtx maps a Collection[T] to a Collection[R], checking ctx for
+	// cancellation before each item.
+
+	// Example: integers
+	nums := collection.New([]int{1, 2, 3})
+	labels, err := collection.MapToCtx(context.Background(), nums, func(ctx context.Context, n int) string {
+		return fmt.Sprintf("#%d", n)
+	})
+	collection.Dump(labels.Items())
+	fmt.Println(err)
+	// #[]string [
+	//   0 => "#1" #string
+	//   1 => "#2" #string
+	//   2 => "#3" #string
+	// ]
+	// <nil>
+}
@@ -0,0 +1,28 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// MapValues returns a new OrderedMap with fn applied to every value,
+	// keeping the original key order.
+
+	// Example: formatting values
+	prices := collection.NewOrderedMap[string, float64]().
+		Set("tea", 2.5).
+		Set("coffee", 3)
+
+	labels := collection.MapValues(prices, func(k string, v float64) string {
+		return fmt.Sprintf("$%.2f", v)
+	})
+
+	out, _ := json.Marshal(labels)
+	fmt.Println(string(out))
+	// {"tea":"$2.50","coffee":"$3.00"}
+}
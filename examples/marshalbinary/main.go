@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// MarshalBinary encodes the collection's items with encoding/gob.
+
+	// Example: cache round trip
+	src := collection.New([]string{"a", "b"})
+	blob, _ := src.MarshalBinary()
+
+	dst := collection.New([]string{})
+	_ = dst.UnmarshalBinary(blob)
+	collection.Dump(dst.Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	// ]
+}
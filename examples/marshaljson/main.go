@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// MarshalJSON encodes the collection as a JSON array of its items.
+
+	// Example: collection as a response field
+	type Response struct {
+		Tags *collection.Collection[string] `json:"tags"`
+	}
+
+	out, _ := json.Marshal(Response{Tags: collection.New([]string{"go", "db"})})
+	fmt.Println(string(out))
+	// {"tags":["go","db"]}
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// MarshalText encodes the collection as the same JSON array text as
+	// MarshalJSON.
+
+	// Example: text form
+	text, _ := collection.New([]string{"a", "b"}).MarshalText()
+	fmt.Println(string(text))
+	// ["a","b"]
+}
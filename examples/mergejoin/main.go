@@ -0,0 +1,37 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// MergeJoin is a sort-merge inner join for inputs that are already sorted
+	// ascending by key.
+
+	// Example: joining sorted time buckets
+	type Reading struct {
+		Minute int
+		Value  float64
+	}
+	type Alert struct {
+		Minute int
+		Msg    string
+	}
+
+	readings := collection.New([]Reading{{1, 0.2}, {2, 0.9}, {3, 0.4}})
+	alerts := collection.New([]Alert{{2, "spike"}, {4, "late"}})
+
+	rows := collection.MergeJoin(readings, alerts,
+		func(r Reading) int { return r.Minute },
+		func(a Alert) int { return a.Minute },
+	)
+
+	for _, r := range rows.Items() {
+		fmt.Println(r.First.Minute, r.First.Value, r.Second.Msg)
+	}
+	// 2 0.9 spike
+}
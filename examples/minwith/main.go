@@ -0,0 +1,28 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// MinWith returns the smallest item according to cmp.
+	// The second return value is false if the collection is empty.
+
+	// Example: structs - multi-key comparator
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := collection.New([]User{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Carol", Age: 40},
+	})
+
+	u, ok := users.MinWith(collection.By(func(u User) int { return u.Age }).Compare)
+	collection.Dump(u.Name, ok)
+	// "Bob" #string
+	// true #bool
+}
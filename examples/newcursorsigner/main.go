@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// NewCursorSigner returns a CursorSigner keyed by secret.
+
+	// Example: from configuration
+	signer := collection.NewCursorSigner([]byte("0123456789abcdef0123456789abcdef"))
+	ids := collection.New([]int{1, 2, 3})
+	page, _ := collection.CursorPaginate(ids, signer, "", 2, func(v int) int { return v })
+	fmt.Println(page.NextCursor != "")
+	// true
+}
@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// NewLazy creates a LazyCollection over the provided slice and borrows it.
+
+	// Example: integers
+	lc := collection.NewLazy([]int{1, 2, 3, 4, 5, 6})
+	out := lc.
+		Filter(func(v int) bool { return v%2 == 0 }).
+		Take(2).
+		Collect()
+	collection.Dump(out.Items())
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 4 #int
+	// ]
+}
@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// NewOrderedMap creates an empty OrderedMap.
+
+	// Example: building a map in order
+	m := collection.NewOrderedMap[string, int]().
+		Set("b", 2).
+		Set("a", 1).
+		Set("c", 3)
+
+	collection.Dump(m.Keys().Items())
+	// #[]string [
+	//   0 => "b" #string
+	//   1 => "a" #string
+	//   2 => "c" #string
+	// ]
+}
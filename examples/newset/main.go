@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// NewSet creates a Set containing the given values.
+
+	// Example: membership checks
+	perms := collection.NewSet("read", "write", "read")
+	fmt.Println(perms.Len(), perms.Has("write"), perms.Has("admin"))
+	// 2 true false
+}
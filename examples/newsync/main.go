@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// NewSync creates a SyncCollection holding a copy of items.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2, 3})
+	sc.Push(4)
+	collection.Dump(sc.Snapshot().Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	//   3 => 4 #int
+	// ]
+}
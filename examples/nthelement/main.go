@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// NthElement returns the item that would be at index n if the collection
+	// were sorted by less.
+	// The second return value is false if n is out of range.
+
+	// Example: integers - second smallest
+	c := collection.New([]int{40, 10, 30, 20})
+	v, ok := c.NthElement(1, func(a, b int) bool { return a < b })
+	collection.Dump(v, ok)
+	// 20 #int
+	// true #bool
+
+	// Example: out of range
+	v2, ok2 := c.NthElement(10, func(a, b int) bool { return a < b })
+	collection.Dump(v2, ok2)
+	// 0 #int
+	// false #bool
+}
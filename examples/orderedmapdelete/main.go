@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Delete removes key from the map, if present, and returns the same map.
+	// This method mutates the map in place.
+
+	// Example: removing a key
+	m := collection.NewOrderedMap[string, int]().
+		Set("a", 1).
+		Set("b", 2).
+		Set("c", 3).
+		Delete("b")
+
+	collection.Dump(m.Keys().Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "c" #string
+	// ]
+}
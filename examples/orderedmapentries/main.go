@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Entries returns an iterator over key/value entries, in order.
+
+	// Example: ranging over entries
+	m := collection.NewOrderedMap[string, int]().Set("b", 2).Set("a", 1)
+	for k, v := range m.Entries() {
+		fmt.Println(k, v)
+	}
+	// b 2
+	// a 1
+}
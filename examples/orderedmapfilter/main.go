@@ -0,0 +1,27 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Filter keeps only the entries for which fn returns true.
+	// This method mutates the map in place and returns the same instance.
+
+	// Example: filter by key and value
+	m := collection.NewOrderedMap[string, int]().
+		Set("a", 1).
+		Set("skip", 2).
+		Set("c", 3)
+
+	m.Filter(func(k string, v int) bool {
+		return k != "skip" && v > 0
+	})
+
+	collection.Dump(m.Keys().Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "c" #string
+	// ]
+}
@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// OrderedMapFromPairs creates an OrderedMap from pairs, in collection order.
+
+	// Example: from a sorted FromMap result
+	c := collection.FromMap(map[string]int{"b": 2, "a": 1})
+	c.Sort(func(x, y collection.Pair[string, int]) bool { return x.Key < y.Key })
+
+	m := collection.OrderedMapFromPairs(c)
+	out, _ := json.Marshal(m)
+	fmt.Println(string(out))
+	// {"a":1,"b":2}
+}
@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Get returns the value stored for key and whether it was present.
+
+	// Example: present and missing keys
+	m := collection.NewOrderedMap[string, int]().Set("a", 1)
+
+	v, ok := m.Get("a")
+	fmt.Println(v, ok)
+	// 1 true
+
+	v2, ok2 := m.Get("z")
+	fmt.Println(v2, ok2)
+	// 0 false
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Has reports whether key is present in the map.
+
+	// Example: checking a key
+	m := collection.NewOrderedMap[string, int]().Set("a", 1)
+	fmt.Println(m.Has("a"), m.Has("z"))
+	// true false
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Keys returns the keys as a new collection, in order.
+
+	// Example: keys in insertion order
+	m := collection.NewOrderedMap[string, int]().Set("z", 26).Set("a", 1)
+	collection.Dump(m.Keys().Items())
+	// #[]string [
+	//   0 => "z" #string
+	//   1 => "a" #string
+	// ]
+}
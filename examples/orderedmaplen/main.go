@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Len returns the number of keys in the map.
+
+	// Example: counting keys
+	m := collection.NewOrderedMap[string, int]().Set("a", 1).Set("b", 2)
+	fmt.Println(m.Len())
+	// 2
+}
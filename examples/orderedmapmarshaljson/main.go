@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// MarshalJSON encodes the map as a JSON object with keys in map order.
+
+	// Example: stable key order
+	m := collection.NewOrderedMap[string, any]().
+		Set("id", 7).
+		Set("name", "router-1").
+		Set("enabled", true)
+
+	out, _ := json.Marshal(m)
+	fmt.Println(string(out))
+	// {"id":7,"name":"router-1","enabled":true}
+}
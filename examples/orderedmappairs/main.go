@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Pairs returns the entries as a new collection of key/value pairs, in order.
+
+	// Example: entries as pairs
+	m := collection.NewOrderedMap[string, int]().Set("b", 2).Set("a", 1)
+	collection.Dump(m.Pairs().Items())
+	// #[]collection.Pair[string,int] [
+	//   0 => #collection.Pair[string,int] {
+	//     +Key   => "b" #string
+	//     +Value => 2 #int
+	//   }
+	//   1 => #collection.Pair[string,int] {
+	//     +Key   => "a" #string
+	//     +Value => 1 #int
+	//   }
+	// ]
+}
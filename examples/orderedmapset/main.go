@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Set stores value for key and returns the same map.
+	// This method mutates the map in place.
+
+	// Example: updating keeps position
+	m := collection.NewOrderedMap[string, int]().
+		Set("a", 1).
+		Set("b", 2).
+		Set("a", 10)
+
+	out, _ := json.Marshal(m)
+	fmt.Println(string(out))
+	// {"a":10,"b":2}
+}
@@ -0,0 +1,26 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// SortByKey reorders the entries so that keys follow less.
+	// This method mutates the map in place and returns the same instance.
+
+	// Example: alphabetical keys
+	m := collection.NewOrderedMap[string, int]().
+		Set("c", 3).
+		Set("a", 1).
+		Set("b", 2)
+
+	m.SortByKey(func(a, b string) bool { return a < b })
+	out, _ := json.Marshal(m)
+	fmt.Println(string(out))
+	// {"a":1,"b":2,"c":3}
+}
@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// UnmarshalJSON decodes a JSON object into the map, preserving the order in
+	// which keys appear in the input.
+
+	// Example: decoding keeps order
+	var m collection.OrderedMap[string, int]
+	_ = json.Unmarshal([]byte(`{"z":1,"a":2,"m":3}`), &m)
+	collection.Dump(m.Keys().Items())
+	// #[]string [
+	//   0 => "z" #string
+	//   1 => "a" #string
+	//   2 => "m" #string
+	// ]
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Values returns the values as a new collection, in key order.
+
+	// Example: values in insertion order
+	m := collection.NewOrderedMap[string, int]().Set("z", 26).Set("a", 1)
+	collection.Dump(m.Values().Items())
+	// #[]int [
+	//   0 => 26 #int
+	//   1 => 1 #int
+	// ]
+}
@@ -0,0 +1,39 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Pad returns a new collection filled with value up to |size| items.
+	// A positive size pads on the right and a negative size pads on the left.
+
+	// Example: integers - pad right
+	c := collection.New([]int{1, 2})
+	collection.Dump(c.Pad(4, 0).Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 0 #int
+	//   3 => 0 #int
+	// ]
+
+	// Example: integers - pad left
+	collection.Dump(c.Pad(-4, 0).Items())
+	// #[]int [
+	//   0 => 0 #int
+	//   1 => 0 #int
+	//   2 => 1 #int
+	//   3 => 2 #int
+	// ]
+
+	// Example: strings - already long enough
+	letters := collection.New([]string{"a", "b", "c"})
+	collection.Dump(letters.Pad(2, "-").Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	//   2 => "c" #string
+	// ]
+}
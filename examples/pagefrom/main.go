@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// From returns the 1-based position of the first item on the page, or 0 if
+	// the page is empty.
+
+	// Example: second page
+	p := collection.New([]int{10, 20, 30, 40, 50}).Paginate(2, 2)
+	fmt.Println(p.From(), p.To())
+	// 3 4
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// HasMorePages reports whether there is a page after the current one.
+
+	// Example: middle page
+	p := collection.New([]int{1, 2, 3, 4, 5}).Paginate(2, 2)
+	fmt.Println(p.HasMorePages())
+	// true
+}
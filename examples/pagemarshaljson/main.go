@@ -0,0 +1,42 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// MarshalJSON encodes the page using Laravel's paginated resource envelope:
+	// the items under "data", page links under "links" and counts under "meta".
+
+	// Example: API response
+	users := collection.New([]string{"ann", "bob", "cat"})
+	p := users.Paginate(1, 2).WithPath("https://api.test/users")
+	out, _ := json.MarshalIndent(p, "", "  ")
+	fmt.Println(string(out))
+	// {
+	//   "data": [
+	//     "ann",
+	//     "bob"
+	//   ],
+	//   "links": {
+	//     "first": "https://api.test/users?page=1",
+	//     "last": "https://api.test/users?page=2",
+	//     "prev": null,
+	//     "next": "https://api.test/users?page=2"
+	//   },
+	//   "meta": {
+	//     "current_page": 1,
+	//     "from": 1,
+	//     "last_page": 2,
+	//     "path": "https://api.test/users",
+	//     "per_page": 2,
+	//     "to": 2,
+	//     "total": 3
+	//   }
+	// }
+}
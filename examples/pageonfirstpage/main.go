@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// OnFirstPage reports whether the current page is the first page.
+
+	// Example: first page
+	p := collection.New([]int{1, 2, 3}).Paginate(1, 2)
+	fmt.Println(p.OnFirstPage())
+	// true
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// OnLastPage reports whether the current page is the last page or beyond it.
+
+	// Example: last page
+	p := collection.New([]int{1, 2, 3}).Paginate(2, 2)
+	fmt.Println(p.OnLastPage())
+	// true
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// URL returns the link to the given page number, built from Path.
+
+	// Example: existing query string
+	p := collection.New([]int{1, 2, 3}).Paginate(1, 2).WithPath("/users?sort=name")
+	fmt.Println(p.URL(2))
+	// /users?sort=name&page=2
+}
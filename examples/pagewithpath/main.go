@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// WithPath returns a copy of the page whose links are built from path.
+
+	// Example: base URL
+	p := collection.New([]int{1, 2, 3}).Paginate(1, 2).WithPath("https://api.test/users")
+	fmt.Println(p.URL(2))
+	// https://api.test/users?page=2
+}
@@ -0,0 +1,42 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Paginate slices the collection into the requested page and returns it as
+	// a Page, along with the total item count and the last page number.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 3, 4, 5, 6, 7})
+	p := c.Paginate(2, 3)
+	collection.Dump(p.Items.Items())
+	// #[]int [
+	//   0 => 4 #int
+	//   1 => 5 #int
+	//   2 => 6 #int
+	// ]
+	fmt.Println(p.Total, p.CurrentPage, p.LastPage, p.HasMorePages())
+	// 7 2 3 true
+
+	// Example: structs
+	type User struct {
+		ID int
+	}
+
+	users := collection.New([]User{{ID: 1}, {ID: 2}, {ID: 3}})
+	last := users.Paginate(2, 2)
+	collection.Dump(last.Items.Items())
+	// #[]main.User [
+	//   0 => #main.User {
+	//     +ID => 3 #int
+	//   }
+	// ]
+	fmt.Println(last.OnLastPage(), last.From(), last.To())
+	// true 3 3
+}
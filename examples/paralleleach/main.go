@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"sync/atomic"
+)
+
+func main() {
+	// ParallelEach runs fn for every item using up to workers goroutines and
+	// returns the same collection.
+
+	// Example: integers - concurrent sum
+	var total atomic.Int64
+	collection.New([]int{1, 2, 3, 4}).ParallelEach(2, func(v int) {
+		total.Add(int64(v))
+	})
+	collection.Dump(total.Load())
+	// 10 #int64
+}
@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ParallelFilter keeps only the elements for which fn returns true,
+	// evaluating fn on up to workers goroutines.
+	// This method mutates the collection in place and returns the same instance.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 3, 4, 5, 6})
+	c.ParallelFilter(3, func(v int) bool { return v%2 == 0 })
+	collection.Dump(c.Items())
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 4 #int
+	//   2 => 6 #int
+	// ]
+}
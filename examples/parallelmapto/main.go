@@ -0,0 +1,36 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"strings"
+)
+
+func main() {
+	// ParallelMapTo maps a Collection[T] to a Collection[R] using up to workers
+	// goroutines.
+
+	// Example: integers - squares
+	nums := collection.New([]int{1, 2, 3, 4})
+	squares := collection.ParallelMapTo(nums, 2, func(n int) int {
+		return n * n
+	})
+	collection.Dump(squares.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 4 #int
+	//   2 => 9 #int
+	//   3 => 16 #int
+	// ]
+
+	// Example: strings - CPU-heavy formatting
+	words := collection.New([]string{"go", "forj"})
+	upper := collection.ParallelMapTo(words, 0, strings.ToUpper)
+	collection.Dump(upper.Items())
+	// #[]string [
+	//   0 => "GO" #string
+	//   1 => "FORJ" #string
+	// ]
+}
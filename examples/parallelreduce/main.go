@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ParallelReduce collapses the collection into a single value using up to
+	// workers goroutines.
+
+	// Example: integers - sum
+	sum := collection.New([]int{1, 2, 3, 4, 5}).ParallelReduce(2, 0, func(a, b int) int {
+		return a + b
+	})
+	collection.Dump(sum)
+	// 15 #int
+
+	// Example: strings - order-preserving concatenation
+	joined := collection.New([]string{"a", "b", "c", "d"}).ParallelReduce(4, "", func(a, b string) string {
+		return a + b
+	})
+	collection.Dump(joined)
+	// "abcd" #string
+}
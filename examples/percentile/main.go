@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Percentile returns the p-th percentile of the collection, for p in
+	// [0, 100], using the QuantileR7 method.
+	// Returns (0, false) if the collection is empty or p is out of range.
+
+	// Example: p95 latency
+	latencies := collection.NewNumeric([]int{120, 80, 95, 300, 110, 90, 105, 85, 100, 250})
+	p95, ok := latencies.Percentile(95)
+	collection.Dump(p95, ok)
+	// 277.500000 #float64
+	// true #bool
+}
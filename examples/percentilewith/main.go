@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// PercentileWith returns the p-th percentile of the collection, for p in
+	// [0, 100], using the given interpolation method.
+	// Returns (0, false) if the collection is empty, p is out of range, or the
+	// method is unknown.
+
+	// Example: nearest-rank vs R-7
+	c := collection.NewNumeric([]int{15, 20, 35, 40, 50})
+	nr, _ := c.PercentileWith(40, collection.QuantileNearestRank)
+	r7, _ := c.PercentileWith(40, collection.QuantileR7)
+	collection.Dump(nr, r7)
+	// 20.000000 #float64
+	// 29.000000 #float64
+}
@@ -0,0 +1,57 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+	"strings"
+)
+
+func main() {
+	// Pivot builds a crosstab from the collection: items are grouped by rowKey
+	// and colKey, and each group is reduced to a cell value with agg.
+
+	// Example: errors per region and month
+	type Event struct {
+		Region string
+		Month  string
+		Errors int
+	}
+
+	events := collection.New([]Event{
+		{"us-east", "jan", 3},
+		{"us-west", "jan", 1},
+		{"us-east", "feb", 4},
+		{"us-east", "jan", 2},
+	})
+
+	sumErrors := func(es []Event) int {
+		total := 0
+		for _, e := range es {
+			total += e.Errors
+		}
+		return total
+	}
+
+	table := collection.Pivot(events,
+		func(e Event) string { return e.Region },
+		func(e Event) string { return e.Month },
+		sumErrors,
+	)
+
+	for _, row := range table.ToStrings() {
+		fmt.Println(strings.Join(row, " | "))
+	}
+	//  | jan | feb | Total
+	// us-east | 5 | 4 | 9
+	// us-west | 1 | 0 | 1
+	// Total | 6 | 4 | 10
+
+	// Example: JSON export
+	out, _ := json.Marshal(table)
+	fmt.Println(string(out))
+	// {"rows":["us-east","us-west"],"columns":["jan","feb"],"cells":[[5,4],[1,0]],"row_totals":[9,1],"column_totals":[6,4],"grand_total":10}
+}
@@ -0,0 +1,31 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Fill sets every cell that has no items to value and returns the same table.
+	// This method mutates the table in place.
+
+	// Example: marking gaps
+	type Sale struct {
+		Store   string
+		Product string
+	}
+
+	sales := collection.New([]Sale{{"north", "tea"}, {"south", "coffee"}})
+	count := func(s []Sale) int { return len(s) }
+
+	table := collection.Pivot(sales,
+		func(s Sale) string { return s.Store },
+		func(s Sale) string { return s.Product },
+		count,
+	).Fill(-1)
+	fmt.Println(table.Cells)
+	// [[1 -1] [-1 1]]
+}
@@ -0,0 +1,31 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Has reports whether the cell at row i and column j was built from at
+	// least one item.
+
+	// Example: checking for missing cells
+	type Sale struct {
+		Store   string
+		Product string
+	}
+
+	sales := collection.New([]Sale{{"north", "tea"}, {"south", "coffee"}})
+	count := func(s []Sale) int { return len(s) }
+
+	table := collection.Pivot(sales,
+		func(s Sale) string { return s.Store },
+		func(s Sale) string { return s.Product },
+		count,
+	)
+	fmt.Println(table.Has(0, 0), table.Has(0, 1))
+	// true false
+}
@@ -0,0 +1,29 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// SortColumns reorders the columns so that column keys follow less, and
+	// returns the same table.
+	// This method mutates the table in place.
+
+	// Example: numeric column order
+	type Reading struct{ Sensor, Hour int }
+
+	readings := collection.New([]Reading{{1, 14}, {1, 9}, {2, 14}})
+	count := func(r []Reading) int { return len(r) }
+
+	table := collection.Pivot(readings,
+		func(r Reading) int { return r.Sensor },
+		func(r Reading) int { return r.Hour },
+		count,
+	).SortColumns(func(a, b int) bool { return a < b })
+	fmt.Println(table.Columns, table.Cells)
+	// [9 14] [[1 1] [0 1]]
+}
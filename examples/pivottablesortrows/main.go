@@ -0,0 +1,29 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// SortRows reorders the rows so that row keys follow less, and returns the
+	// same table.
+	// This method mutates the table in place.
+
+	// Example: alphabetical rows
+	type Hit struct{ Host, Code string }
+
+	hits := collection.New([]Hit{{"web-2", "200"}, {"web-1", "500"}})
+	count := func(h []Hit) int { return len(h) }
+
+	table := collection.Pivot(hits,
+		func(h Hit) string { return h.Host },
+		func(h Hit) string { return h.Code },
+		count,
+	).SortRows(func(a, b string) bool { return a < b })
+	fmt.Println(table.Rows, table.Cells)
+	// [web-1 web-2] [[0 1] [1 0]]
+}
@@ -0,0 +1,28 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// ToStrings exports the table as a matrix of strings, including a header
+	// row, a header column and totals.
+
+	// Example: exporting a table
+	type Vote struct{ Region, Choice string }
+
+	votes := collection.New([]Vote{{"east", "yes"}, {"west", "no"}, {"east", "no"}})
+	count := func(v []Vote) int { return len(v) }
+
+	table := collection.Pivot(votes,
+		func(v Vote) string { return v.Region },
+		func(v Vote) string { return v.Choice },
+		count,
+	)
+	fmt.Println(table.ToStrings())
+	// [[ yes no Total] [east 1 1 2] [west 0 1 1] [Total 1 2 3]]
+}
@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Quantiles returns the quantiles of the collection at each p in ps, where
+	// every p is in [0, 1], using the QuantileR7 method.
+	// Returns (nil, false) if the collection is empty or any p is out of range.
+
+	// Example: quartiles
+	c := collection.NewNumeric([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	qs, ok := c.Quantiles(0.25, 0.5, 0.75)
+	collection.Dump(qs, ok)
+	// #[]float64 [
+	//   0 => 3.000000 #float64
+	//   1 => 5.000000 #float64
+	//   2 => 7.000000 #float64
+	// ]
+	// true #bool
+}
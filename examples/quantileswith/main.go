@@ -0,0 +1,23 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// QuantilesWith returns the quantiles of the collection at each p in ps,
+	// where every p is in [0, 1], using the given interpolation method.
+	// Returns (nil, false) if the collection is empty, any p is out of range,
+	// or the method is unknown.
+
+	// Example: Hyndman & Fan type 4
+	c := collection.NewNumeric([]float64{10, 20, 30, 40})
+	qs, _ := c.QuantilesWith(collection.QuantileR4, 0.25, 0.5, 0.9)
+	collection.Dump(qs)
+	// #[]float64 [
+	//   0 => 10.000000 #float64
+	//   1 => 20.000000 #float64
+	//   2 => 36.000000 #float64
+	// ]
+}
@@ -0,0 +1,26 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Range returns the difference between the largest and smallest values.
+	// Returns (0, false) if the collection is empty.
+
+	// Example: integers
+	c := collection.NewNumeric([]int{7, 3, 12, 5})
+	r, ok := c.Range()
+	collection.Dump(r, ok)
+	// 9.000000 #float64
+	// true #bool
+
+	// Example: narrow integers
+	r2, _ := collection.NewNumeric([]int8{-128, 127}).Range()
+	fmt.Println(r2)
+	// 255
+}
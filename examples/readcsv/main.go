@@ -0,0 +1,42 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/goforj/collection"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	// ReadCSV streams records from a configured csv.Reader, yielding each parsed
+	// item or the error for that record.
+
+	// Example: tab-separated, skipping bad rows
+	cr := csv.NewReader(strings.NewReader("name\tage\nann\t31\nbob\t?\ncy\t27\n"))
+	cr.Comma = '\t'
+
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	rows := collection.ReadCSV(cr, true, func(rec []string) (Person, error) {
+		age, err := strconv.Atoi(rec[1])
+		return Person{Name: rec[0], Age: age}, err
+	})
+
+	for p, err := range rows {
+		if err != nil {
+			fmt.Println("skip:", err)
+			continue
+		}
+		fmt.Println(p.Name, p.Age)
+	}
+	// ann 31
+	// skip: line 3: strconv.Atoi: parsing "?": invalid syntax
+	// cy 27
+}
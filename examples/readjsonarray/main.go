@@ -0,0 +1,35 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+	"strings"
+)
+
+func main() {
+	// ReadJSONArray streams the elements of a top-level JSON array from r,
+	// yielding each decoded element or an error.
+
+	// Example: streaming a large export
+	input := `[{"id":1,"total":9.5},{"id":2,"total":"n/a"},{"id":3,"total":4}]`
+
+	type Order struct {
+		ID    int     `json:"id"`
+		Total float64 `json:"total"`
+	}
+
+	sum := 0.0
+	for o, err := range collection.ReadJSONArray[Order](strings.NewReader(input)) {
+		if err != nil {
+			fmt.Println("skip:", err)
+			continue
+		}
+		sum += o.Total
+	}
+	fmt.Println(sum)
+	// skip: item 1: json: cannot unmarshal string into Go struct field Order.total of type float64
+	// 13.5
+}
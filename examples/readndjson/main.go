@@ -0,0 +1,29 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+	"strings"
+)
+
+func main() {
+	// ReadNDJSON streams newline-delimited JSON from r, yielding each decoded
+	// value or the error for that line.
+
+	// Example: skipping bad lines
+	input := "1\n2\noops\n4\n"
+	total := 0
+	for v, err := range collection.ReadNDJSON[int](strings.NewReader(input)) {
+		if err != nil {
+			fmt.Println("skip:", err)
+			continue
+		}
+		total += v
+	}
+	fmt.Println(total)
+	// skip: line 3: invalid character 'o' looking for beginning of value
+	// 7
+}
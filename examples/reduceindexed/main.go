@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// ReduceIndexed collapses the collection into a single value of type R,
+	// passing each item's index to fn.
+
+	// Example: numbered list
+	steps := collection.New([]string{"build", "test", "ship"})
+	out := collection.ReduceIndexed(steps, "", func(acc string, i int, s string) string {
+		return acc + fmt.Sprintf("%d. %s\n", i+1, s)
+	})
+	fmt.Print(out)
+	// 1. build
+	// 2. test
+	// 3. ship
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ReduceRight collapses the collection into a single value of type R,
+	// folding from right to left.
+
+	// Example: strings - reversed concatenation
+	letters := collection.New([]string{"a", "b", "c"})
+	out := collection.ReduceRight(letters, "", func(acc string, s string) string {
+		return acc + s
+	})
+	collection.Dump(out)
+	// "cba" #string
+}
@@ -0,0 +1,39 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ReduceTo collapses the collection into a single value of a different type R.
+
+	// Example: building a lookup map
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	users := collection.New([]User{
+		{ID: 1, Name: "Alice"},
+		{ID: 2, Name: "Bob"},
+	})
+
+	byID := collection.ReduceTo(users, map[int]string{}, func(acc map[int]string, u User) map[int]string {
+		acc[u.ID] = u.Name
+		return acc
+	})
+	collection.Dump(byID)
+	// #map[int]string {
+	//    1 => "Alice" #string
+	//    2 => "Bob" #string
+	// }
+
+	// Example: total string length
+	words := collection.New([]string{"go", "forj"})
+	total := collection.ReduceTo(words, 0, func(acc int, w string) int {
+		return acc + len(w)
+	})
+	collection.Dump(total)
+	// 6 #int
+}
@@ -0,0 +1,31 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ReduceWhile collapses the collection into a single value of type R,
+	// stopping as soon as fn reports that it is done.
+
+	// Example: fill a budget
+	costs := collection.New([]int{30, 40, 50, 10})
+	spent := collection.ReduceWhile(costs, 0, func(acc, cost int) (int, bool) {
+		if acc+cost > 100 {
+			return acc, false
+		}
+		return acc + cost, true
+	})
+	collection.Dump(spent)
+	// 70 #int
+
+	// Example: first N characters across words
+	words := collection.New([]string{"go", "forj", "collection"})
+	prefix := collection.ReduceWhile(words, "", func(acc string, w string) (string, bool) {
+		acc += w
+		return acc, len(acc) < 5
+	})
+	collection.Dump(prefix)
+	// "goforj" #string
+}
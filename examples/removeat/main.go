@@ -0,0 +1,28 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// RemoveAt removes the item at index i and returns it, along with a boolean
+	// indicating whether the index was within bounds.
+
+	// Example: integers
+	c := collection.New([]int{10, 20, 30})
+	v, ok := c.RemoveAt(1)
+	collection.Dump(v, ok, c.Items())
+	// 20 #int
+	// true #bool
+	// #[]int [
+	//   0 => 10 #int
+	//   1 => 30 #int
+	// ]
+
+	// Example: out of range
+	v2, ok2 := c.RemoveAt(5)
+	collection.Dump(v2, ok2)
+	// 0 #int
+	// false #bool
+}
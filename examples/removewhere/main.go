@@ -0,0 +1,43 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// RemoveWhere removes every item for which pred returns true.
+	// It is the inverse of Filter.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 3, 4, 5})
+	c.RemoveWhere(func(v int) bool {
+		return v%2 == 0
+	})
+	collection.Dump(c.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 3 #int
+	//   2 => 5 #int
+	// ]
+
+	// Example: structs
+	type Job struct {
+		ID   int
+		Done bool
+	}
+
+	jobs := collection.New([]Job{
+		{ID: 1, Done: true},
+		{ID: 2, Done: false},
+	})
+
+	jobs.RemoveWhere(func(j Job) bool { return j.Done })
+	collection.Dump(jobs.Items())
+	// #[]main.Job [
+	//   0 => #main.Job {
+	//     +ID   => 2 #int
+	//     +Done => false #bool
+	//   }
+	// ]
+}
@@ -0,0 +1,29 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Replace overwrites the items at the given indices with new values.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 3})
+	c.Replace(map[int]int{0: 10, 2: 30, 9: 90})
+	collection.Dump(c.Items())
+	// #[]int [
+	//   0 => 10 #int
+	//   1 => 2 #int
+	//   2 => 30 #int
+	// ]
+
+	// Example: strings
+	names := collection.New([]string{"ann", "bob"})
+	names.Replace(map[int]string{1: "bea"})
+	collection.Dump(names.Items())
+	// #[]string [
+	//   0 => "ann" #string
+	//   1 => "bea" #string
+	// ]
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// SampleStdDev returns the sample standard deviation of the collection.
+	// Returns (0, false) if the collection has fewer than two items.
+
+	// Example: latencies in milliseconds
+	c := collection.NewNumeric([]float64{12, 15, 11, 18})
+	sd, ok := c.SampleStdDev()
+	collection.Dump(sd, ok)
+	// 3.162278 #float64
+	// true #bool
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// SampleVariance returns the sample variance of the collection, using
+	// Bessel's correction (dividing by n-1).
+	// Returns (0, false) if the collection has fewer than two items.
+
+	// Example: integers
+	c := collection.NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	v, ok := c.SampleVariance()
+	collection.Dump(v, ok)
+	// 4.571429 #float64
+	// true #bool
+}
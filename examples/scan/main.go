@@ -0,0 +1,36 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Scan folds the collection from left to right like Reduce, but returns every
+	// intermediate accumulator instead of only the final one.
+
+	// Example: running balance
+	txns := collection.New([]int{100, -30, 50, -20})
+	balance := collection.Scan(txns, 0, func(acc, amount int) int {
+		return acc + amount
+	})
+	collection.Dump(balance.Items())
+	// #[]int [
+	//   0 => 100 #int
+	//   1 => 70 #int
+	//   2 => 120 #int
+	//   3 => 100 #int
+	// ]
+
+	// Example: accumulating into a different type
+	words := collection.New([]string{"go", "forj", "collection"})
+	paths := collection.Scan(words, "", func(acc string, w string) string {
+		return acc + "/" + w
+	})
+	collection.Dump(paths.Items())
+	// #[]string [
+	//   0 => "/go" #string
+	//   1 => "/go/forj" #string
+	//   2 => "/go/forj/collection" #string
+	// ]
+}
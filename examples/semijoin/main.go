@@ -0,0 +1,27 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// SemiJoin returns a new collection with the items in a that have at least
+	// one match in b.
+
+	// Example: users with at least one login
+	type Login struct{ UserID int }
+
+	users := collection.New([]int{1, 2, 3})
+	logins := collection.New([]Login{{UserID: 3}, {UserID: 1}, {UserID: 3}})
+
+	active := collection.SemiJoin(users, logins,
+		func(id int) int { return id },
+		func(l Login) int { return l.UserID },
+	)
+	collection.Dump(active.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 3 #int
+	// ]
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Add inserts values into the set and returns the same set.
+	// This method mutates the set in place.
+
+	// Example: adding values
+	s := collection.NewSet[int]().Add(3, 1).Add(1, 2)
+	collection.Dump(s.Sorted(func(a, b int) bool { return a < b }).Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Clone returns a new set with the same values.
+
+	// Example: independent copy
+	a := collection.NewSet(1, 2)
+	b := a.Clone().Add(3)
+	fmt.Println(a.Len(), b.Len())
+	// 2 3
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Difference returns a new set with the values in s that are not in other.
+
+	// Example: integers
+	a := collection.NewSet(1, 2, 3)
+	b := collection.NewSet(2)
+	collection.Dump(a.Difference(b).Sorted(func(x, y int) bool { return x < y }).Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 3 #int
+	// ]
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// DifferenceWith removes every value in other from s and returns s.
+	// This method mutates the set in place.
+
+	// Example: revoking permissions
+	perms := collection.NewSet("read", "write", "delete")
+	perms.DifferenceWith(collection.NewSet("delete"))
+	fmt.Println(perms.Len(), perms.Has("delete"))
+	// 2 false
+}
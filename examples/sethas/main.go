@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Has reports whether v is in the set.
+
+	// Example: integers
+	s := collection.NewSet(1, 2, 3)
+	fmt.Println(s.Has(2), s.Has(9))
+	// true false
+}
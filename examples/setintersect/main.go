@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Intersect returns a new set with the values that are in both s and other.
+
+	// Example: integers
+	a := collection.NewSet(1, 2, 3)
+	b := collection.NewSet(2, 3, 4)
+	collection.Dump(a.Intersect(b).Sorted(func(x, y int) bool { return x < y }).Items())
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 3 #int
+	// ]
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// IntersectWith removes every value from s that is not in other and returns s.
+	// This method mutates the set in place.
+
+	// Example: integers
+	s := collection.NewSet(1, 2, 3)
+	s.IntersectWith(collection.NewSet(2, 3, 4))
+	fmt.Println(s.Len(), s.Has(1))
+	// 2 false
+}
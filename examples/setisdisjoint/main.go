@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// IsDisjoint reports whether s and other have no values in common.
+
+	// Example: integers
+	a := collection.NewSet(1, 2)
+	fmt.Println(a.IsDisjoint(collection.NewSet(3, 4)), a.IsDisjoint(collection.NewSet(2)))
+	// true false
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// IsSubset reports whether every value in s is also in other.
+
+	// Example: required permissions
+	required := collection.NewSet("read", "write")
+	granted := collection.NewSet("read", "write", "admin")
+	fmt.Println(required.IsSubset(granted))
+	// true
+}
@@ -0,0 +1,17 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// IsSuperset reports whether s contains every value in other.
+
+	// Example: integers
+	fmt.Println(collection.NewSet(1, 2, 3).IsSuperset(collection.NewSet(1, 3)))
+	// true
+}
@@ -0,0 +1,17 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Len returns the number of values in the set.
+
+	// Example: counting values
+	fmt.Println(collection.NewSet(1, 2, 2, 3).Len())
+	// 3
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Remove deletes values from the set and returns the same set.
+	// This method mutates the set in place.
+
+	// Example: removing values
+	s := collection.NewSet(1, 2, 3).Remove(2, 9)
+	collection.Dump(s.Sorted(func(a, b int) bool { return a < b }).Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 3 #int
+	// ]
+}
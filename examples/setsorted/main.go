@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Sorted returns the values as a new collection ordered by less.
+
+	// Example: deterministic export
+	s := collection.NewSet("b", "c", "a")
+	collection.Dump(s.Sorted(func(x, y string) bool { return x < y }).Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	//   2 => "c" #string
+	// ]
+}
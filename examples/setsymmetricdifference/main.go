@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// SymmetricDifference returns a new set with the values that are in exactly
+	// one of s and other.
+
+	// Example: integers
+	a := collection.NewSet(1, 2, 3)
+	b := collection.NewSet(3, 4)
+	collection.Dump(a.SymmetricDifference(b).Sorted(func(x, y int) bool { return x < y }).Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 4 #int
+	// ]
+}
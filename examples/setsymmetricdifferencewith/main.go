@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// SymmetricDifferenceWith updates s to hold the values that are in exactly
+	// one of s and other, and returns s.
+	// This method mutates the set in place.
+
+	// Example: toggling flags
+	flags := collection.NewSet("a", "b")
+	flags.SymmetricDifferenceWith(collection.NewSet("b", "c"))
+	collection.Dump(flags.Sorted(func(x, y string) bool { return x < y }).Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "c" #string
+	// ]
+}
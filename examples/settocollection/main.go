@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// ToCollection returns the values as a new collection, in unspecified order.
+
+	// Example: back to a collection
+	c := collection.NewSet(1, 2, 3).ToCollection()
+	fmt.Println(c.Count())
+	// 3
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Union returns a new set with the values that are in s or other.
+
+	// Example: integers
+	a := collection.NewSet(1, 2)
+	b := collection.NewSet(2, 3)
+	collection.Dump(a.Union(b).Sorted(func(x, y int) bool { return x < y }).Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// UnionWith adds every value from other to s and returns s.
+	// This method mutates the set in place.
+
+	// Example: merging granted permissions
+	granted := collection.NewSet("read")
+	granted.UnionWith(collection.NewSet("write", "read"))
+	fmt.Println(granted.Len(), granted.Has("write"))
+	// 2 true
+}
@@ -0,0 +1,21 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Values returns an iterator over the values in the set, in unspecified order.
+
+	// Example: summing values
+	total := 0
+	for v := range collection.NewSet(1, 2, 3).Values() {
+		total += v
+	}
+	fmt.Println(total)
+	// 6
+}
@@ -0,0 +1,40 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// SortBy sorts the collection in place by the key returned from keyFn,
+	// ascending, and returns the same collection.
+
+	// Example: structs by age
+	type User struct {
+		Name string
+		Age  int
+	}
+
+	users := collection.New([]User{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Carol", Age: 30},
+	})
+
+	collection.SortBy(users, func(u User) int { return u.Age })
+	collection.Dump(users.Items())
+	// #[]main.User [
+	//   0 => #main.User {
+	//     +Name => "Bob" #string
+	//     +Age  => 25 #int
+	//   }
+	//   1 => #main.User {
+	//     +Name => "Alice" #string
+	//     +Age  => 30 #int
+	//   }
+	//   2 => #main.User {
+	//     +Name => "Carol" #string
+	//     +Age  => 30 #int
+	//   }
+	// ]
+}
@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// SortByDesc sorts the collection in place by the key returned from keyFn,
+	// descending, and returns the same collection.
+
+	// Example: strings by length
+	words := collection.New([]string{"go", "forj", "collection", "zig"})
+	collection.SortByDesc(words, func(s string) int { return len(s) })
+	collection.Dump(words.Items())
+	// #[]string [
+	//   0 => "collection" #string
+	//   1 => "forj" #string
+	//   2 => "zig" #string
+	//   3 => "go" #string
+	// ]
+}
@@ -0,0 +1,46 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// SortStable sorts the collection in place using the provided comparison
+	// function, keeping items that compare equal in their original order, and
+	// returns the same collection for chaining.
+
+	// Example: structs - ties keep insertion order
+	type Row struct {
+		ID    int
+		Score int
+	}
+
+	rows := collection.New([]Row{
+		{ID: 1, Score: 20},
+		{ID: 2, Score: 10},
+		{ID: 3, Score: 20},
+		{ID: 4, Score: 10},
+	})
+
+	rows.SortStable(func(a, b Row) bool { return a.Score < b.Score })
+	collection.Dump(rows.Items())
+	// #[]main.Row [
+	//   0 => #main.Row {
+	//     +ID    => 2 #int
+	//     +Score => 10 #int
+	//   }
+	//   1 => #main.Row {
+	//     +ID    => 4 #int
+	//     +Score => 10 #int
+	//   }
+	//   2 => #main.Row {
+	//     +ID    => 1 #int
+	//     +Score => 20 #int
+	//   }
+	//   3 => #main.Row {
+	//     +ID    => 3 #int
+	//     +Score => 20 #int
+	//   }
+	// ]
+}
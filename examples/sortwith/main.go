@@ -0,0 +1,24 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"cmp"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// SortWith sorts the collection in place using a three-way comparison
+	// function and returns the same collection.
+
+	// Example: integers with cmp.Compare
+	c := collection.New([]int{3, 1, 2})
+	c.SortWith(cmp.Compare[int])
+	collection.Dump(c.Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
@@ -0,0 +1,41 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Splice removes deleteCount items starting at start, inserts the given
+	// values in their place, and returns the removed items.
+
+	// Example: integers - replace a range
+	c := collection.New([]int{1, 2, 3, 4, 5})
+	removed := c.Splice(1, 2, 20, 30, 40)
+	collection.Dump(removed, c.Items())
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 3 #int
+	// ]
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 20 #int
+	//   2 => 30 #int
+	//   3 => 40 #int
+	//   4 => 4 #int
+	//   5 => 5 #int
+	// ]
+
+	// Example: strings - remove the tail
+	letters := collection.New([]string{"a", "b", "c", "d"})
+	tail := letters.Splice(2, 10)
+	collection.Dump(tail, letters.Items())
+	// #[]string [
+	//   0 => "c" #string
+	//   1 => "d" #string
+	// ]
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	// ]
+}
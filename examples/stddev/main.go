@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// StdDev returns the population standard deviation of the collection.
+	// Returns (0, false) if the collection is empty.
+
+	// Example: integers
+	c := collection.NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	sd, ok := c.StdDev()
+	collection.Dump(sd, ok)
+	// 2.000000 #float64
+	// true #bool
+}
@@ -0,0 +1,16 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Dump prints the summary with godump and returns it unchanged.
+	// godump renders the summary through its String method.
+
+	// Example: dumping a summary
+	s, _ := collection.NewNumeric([]int{2, 4, 6}).Describe()
+	s.Dump()
+	// count=3 min=2 max=6 mean=4 stddev=1.633 p50=4 p90=5.6 p95=5.8 p99=5.96 #collection.Summary[int]
+}
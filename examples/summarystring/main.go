@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// String formats the summary on a single line, with floats rounded to
+	// four significant digits.
+
+	// Example: printing a summary
+	s, _ := collection.NewNumeric([]int{1, 2, 3}).Describe()
+	fmt.Println(s.String())
+	// count=3 min=1 max=3 mean=2 stddev=0.8165 p50=2 p90=2.8 p95=2.9 p99=2.98
+}
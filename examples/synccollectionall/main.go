@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// All reports whether fn returns true for every item.
+
+	// Example: integers
+	sc := collection.NewSync([]int{2, 4})
+	fmt.Println(sc.All(func(v int) bool { return v%2 == 0 }))
+	// true
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Any reports whether fn returns true for at least one item.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 3, 4})
+	fmt.Println(sc.Any(func(v int) bool { return v%2 == 0 }))
+	// true
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// At returns the item at index i and whether i was within bounds.
+
+	// Example: integers
+	sc := collection.NewSync([]int{10, 20})
+	v, ok := sc.At(1)
+	fmt.Println(v, ok)
+	// 20 true
+}
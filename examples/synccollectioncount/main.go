@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Count returns the number of items.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2, 3})
+	fmt.Println(sc.Count())
+	// 3
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Each calls fn for every item under the read lock.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2, 3})
+	total := 0
+	sc.Each(func(v int) { total += v })
+	fmt.Println(total)
+	// 6
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Filter keeps only the items for which fn returns true.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2, 3, 4})
+	sc.Filter(func(v int) bool { return v%2 == 0 })
+	collection.Dump(sc.Snapshot().Items())
+	// #[]int [
+	//   0 => 2 #int
+	//   1 => 4 #int
+	// ]
+}
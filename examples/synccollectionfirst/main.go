@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// First returns the first item and whether the collection was non-empty.
+
+	// Example: integers
+	sc := collection.NewSync([]int{10, 20})
+	v, ok := sc.First()
+	fmt.Println(v, ok)
+	// 10 true
+}
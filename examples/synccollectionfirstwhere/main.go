@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// FirstWhere returns the first item for which fn returns true.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 4, 6})
+	v, ok := sc.FirstWhere(func(v int) bool { return v%2 == 0 })
+	fmt.Println(v, ok)
+	// 4 true
+}
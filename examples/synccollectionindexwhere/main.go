@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// IndexWhere returns the index of the first item for which fn returns true.
+
+	// Example: strings
+	sc := collection.NewSync([]string{"a", "b", "c"})
+	i, ok := sc.IndexWhere(func(v string) bool { return v == "c" })
+	fmt.Println(i, ok)
+	// 2 true
+}
@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// IsEmpty reports whether the collection has no items.
+
+	// Example: zero value
+	var sc collection.SyncCollection[string]
+	fmt.Println(sc.IsEmpty())
+	// true
+}
@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Last returns the last item and whether the collection was non-empty.
+
+	// Example: integers
+	sc := collection.NewSync([]int{10, 20})
+	v, ok := sc.Last()
+	fmt.Println(v, ok)
+	// 20 true
+}
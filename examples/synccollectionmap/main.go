@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Map replaces each item with the result of fn.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2})
+	sc.Map(func(v int) int { return v * 10 })
+	collection.Dump(sc.Snapshot().Items())
+	// #[]int [
+	//   0 => 10 #int
+	//   1 => 20 #int
+	// ]
+}
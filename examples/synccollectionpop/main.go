@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// Pop removes and returns the last item.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2})
+	v, ok := sc.Pop()
+	fmt.Println(v, ok, sc.Count())
+	// 2 true 1
+}
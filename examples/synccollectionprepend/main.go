@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Prepend adds values to the beginning of the collection.
+
+	// Example: integers
+	sc := collection.NewSync([]int{3})
+	sc.Prepend(1, 2)
+	collection.Dump(sc.Snapshot().Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Push appends values to the end of the collection.
+
+	// Example: strings
+	sc := collection.NewSync([]string{"a"})
+	sc.Push("b", "c")
+	collection.Dump(sc.Snapshot().Items())
+	// #[]string [
+	//   0 => "a" #string
+	//   1 => "b" #string
+	//   2 => "c" #string
+	// ]
+}
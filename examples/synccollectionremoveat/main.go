@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// RemoveAt removes and returns the item at index i.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2, 3})
+	v, ok := sc.RemoveAt(0)
+	fmt.Println(v, ok, sc.Count())
+	// 1 true 2
+}
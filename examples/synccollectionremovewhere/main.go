@@ -0,0 +1,19 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// RemoveWhere removes every item for which fn returns true.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2, 3, 4})
+	sc.RemoveWhere(func(v int) bool { return v > 2 })
+	collection.Dump(sc.Snapshot().Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	// ]
+}
@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Reverse reverses the order of the items in place.
+
+	// Example: integers
+	sc := collection.NewSync([]int{1, 2, 3})
+	sc.Reverse()
+	collection.Dump(sc.Snapshot().Items())
+	// #[]int [
+	//   0 => 3 #int
+	//   1 => 2 #int
+	//   2 => 1 #int
+	// ]
+}
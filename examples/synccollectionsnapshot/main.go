@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Snapshot returns a consistent copy of the current items as a plain
+	// Collection.
+
+	// Example: integers
+	sc := collection.NewSync([]int{3, 1, 2})
+	snap := sc.Snapshot()
+	sc.Push(4)
+	collection.Dump(snap.Sort(func(a, b int) bool { return a < b }).Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
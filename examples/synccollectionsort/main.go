@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Sort sorts the collection in place using less.
+
+	// Example: integers
+	sc := collection.NewSync([]int{3, 1, 2})
+	sc.Sort(func(a, b int) bool { return a < b })
+	collection.Dump(sc.Snapshot().Items())
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 2 #int
+	//   2 => 3 #int
+	// ]
+}
@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Update runs fn with exclusive access to the underlying collection.
+
+	// Example: transaction
+	stock := collection.NewSync([]int{5, 0, 3, 0})
+	stock.Update(func(c *collection.Collection[int]) {
+		c.Filter(func(v int) bool { return v > 0 }).
+			Sort(func(a, b int) bool { return a < b })
+	})
+	collection.Dump(stock.Snapshot().Items())
+	// #[]int [
+	//   0 => 3 #int
+	//   1 => 5 #int
+	// ]
+}
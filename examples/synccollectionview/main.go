@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// View runs fn with shared, read-only access to the underlying collection.
+
+	// Example: consistent reads
+	sc := collection.NewSync([]int{4, 8, 15})
+	sc.View(func(c *collection.Collection[int]) {
+		first, _ := c.First()
+		last, _ := c.Last()
+		fmt.Println(c.Count(), first, last)
+	})
+	// 3 4 15
+}
@@ -0,0 +1,32 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// TimesCtx creates a new collection by calling fn(ctx, i) for i = 1..count,
+	// checking ctx for cancellation before each call.
+
+	// Example: cancellation part way through
+	ctx, cancel := context.WithCancel(context.Background())
+	out, err := collection.TimesCtx(ctx, 5, func(ctx context.Context, i int) int {
+		if i == 3 {
+			cancel()
+		}
+		return i * 10
+	})
+	collection.Dump(out.Items())
+	fmt.Println(err)
+	// #[]int [
+	//   0 => 10 #int
+	//   1 => 20 #int
+	//   2 => 30 #int
+	// ]
+	// context canceled
+}
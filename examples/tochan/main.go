@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// ToChan sends every item into a new channel with the given buffer size and
+	// closes it once all items have been sent.
+
+	// Example: integers
+	for v := range collection.New([]int{1, 2, 3}).ToChan(1) {
+		fmt.Println(v)
+	}
+	// 1
+	// 2
+	// 3
+}
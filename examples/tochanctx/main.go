@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// ToChanCtx sends every item into a new channel with the given buffer size,
+	// stopping early when ctx is done. The channel is always closed.
+
+	// Example: consumer stops early
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := collection.New([]int{1, 2, 3, 4}).ToChanCtx(ctx, 0)
+	fmt.Println(<-ch)
+	cancel()
+	// 1
+}
@@ -0,0 +1,33 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"os"
+	"strconv"
+)
+
+func main() {
+	// ToCSV writes the collection to w as comma-separated CSV, one record per item.
+
+	// Example: exporting structs
+	type Invoice struct {
+		ID     int
+		Client string
+		Total  float64
+	}
+
+	invoices := collection.New([]Invoice{
+		{ID: 1, Client: "Acme, Inc.", Total: 120.5},
+		{ID: 2, Client: "Globex", Total: 80},
+	})
+
+	_ = invoices.ToCSV(os.Stdout, []string{"id", "client", "total"}, func(i Invoice) []string {
+		return []string{strconv.Itoa(i.ID), i.Client, strconv.FormatFloat(i.Total, 'f', 2, 64)}
+	})
+	// id,client,total
+	// 1,"Acme, Inc.",120.50
+	// 2,Globex,80.00
+}
@@ -0,0 +1,28 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// TopK returns a new collection with the k items that sort first under less,
+	// in ranked order.
+
+	// Example: integers - three largest
+	c := collection.New([]int{5, 1, 9, 3, 7})
+	top := c.TopK(3, func(a, b int) bool { return a > b })
+	collection.Dump(top.Items(), c.Items())
+	// #[]int [
+	//   0 => 9 #int
+	//   1 => 7 #int
+	//   2 => 5 #int
+	// ]
+	// #[]int [
+	//   0 => 5 #int
+	//   1 => 1 #int
+	//   2 => 9 #int
+	//   3 => 3 #int
+	//   4 => 7 #int
+	// ]
+}
@@ -0,0 +1,37 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// TopKBy returns a new collection with the k items whose keys are largest,
+	// highest first.
+
+	// Example: structs - top devices by error count
+	type Device struct {
+		Name   string
+		Errors int
+	}
+
+	devices := collection.New([]Device{
+		{Name: "r1", Errors: 3},
+		{Name: "r2", Errors: 15},
+		{Name: "r3", Errors: 22},
+		{Name: "r4", Errors: 8},
+	})
+
+	top := collection.TopKBy(devices, 2, func(d Device) int { return d.Errors })
+	collection.Dump(top.Items())
+	// #[]main.Device [
+	//   0 => #main.Device {
+	//     +Name   => "r3" #string
+	//     +Errors => 22 #int
+	//   }
+	//   1 => #main.Device {
+	//     +Name   => "r2" #string
+	//     +Errors => 15 #int
+	//   }
+	// ]
+}
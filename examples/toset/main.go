@@ -0,0 +1,20 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// ToSet creates a Set from the items in the collection.
+
+	// Example: deduplicating tags
+	tags := collection.New([]string{"go", "db", "go", "api"})
+	set := collection.ToSet(tags)
+	collection.Dump(set.Sorted(func(a, b string) bool { return a < b }).Items())
+	// #[]string [
+	//   0 => "api" #string
+	//   1 => "db" #string
+	//   2 => "go" #string
+	// ]
+}
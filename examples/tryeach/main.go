@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// TryEach runs a fallible fn for every item in the collection.
+
+	// Example: strings - validation
+	names := collection.New([]string{"alice", "", "carol"})
+	err := names.TryEach(collection.FailFast, func(s string) error {
+		if s == "" {
+			return errors.New("empty name")
+		}
+		return nil
+	})
+	fmt.Println(err)
+	// item 1: empty name
+}
@@ -0,0 +1,29 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// TryFilter keeps only the elements for which a fallible fn returns true.
+
+	// Example: integers - collect all
+	c := collection.New([]int{1, -2, 3, 4})
+	_, err := c.TryFilter(collection.CollectAll, func(v int) (bool, error) {
+		if v < 0 {
+			return false, fmt.Errorf("negative value %d", v)
+		}
+		return v%2 == 1, nil
+	})
+	collection.Dump(c.Items())
+	fmt.Println(err)
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 3 #int
+	// ]
+	// item 1: negative value -2
+}
@@ -0,0 +1,36 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+	"strconv"
+)
+
+func main() {
+	// TryMapTo maps a Collection[T] to a Collection[R] using a fallible fn.
+
+	// Example: integers - fail fast
+	raw := collection.New([]string{"1", "x", "3"})
+	nums, err := collection.TryMapTo(raw, collection.FailFast, strconv.Atoi)
+	collection.Dump(nums.Items())
+	fmt.Println(err)
+	// #[]int [
+	//   0 => 1 #int
+	// ]
+	// item 1: strconv.Atoi: parsing "x": invalid syntax
+
+	// Example: integers - collect all
+	raw2 := collection.New([]string{"1", "x", "3", "y"})
+	nums2, err2 := collection.TryMapTo(raw2, collection.CollectAll, strconv.Atoi)
+	collection.Dump(nums2.Items())
+	fmt.Println(err2)
+	// #[]int [
+	//   0 => 1 #int
+	//   1 => 3 #int
+	// ]
+	// item 1: strconv.Atoi: parsing "x": invalid syntax
+	// item 3: strconv.Atoi: parsing "y": invalid syntax
+}
@@ -0,0 +1,27 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// TryReduce collapses the collection into a single value using a fallible fn.
+
+	// Example: integers - overflow guard
+	c := collection.New([]int{40, 50, 30})
+	total, err := c.TryReduce(collection.FailFast, 0, func(acc, v int) (int, error) {
+		if acc+v > 100 {
+			return acc, errors.New("budget exceeded")
+		}
+		return acc + v, nil
+	})
+	collection.Dump(total)
+	fmt.Println(err)
+	// 90 #int
+	// item 2: budget exceeded
+}
@@ -0,0 +1,30 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// UnmarshalBinary decodes data produced by MarshalBinary into the collection,
+	// replacing its items.
+	// This method mutates the collection in place.
+
+	// Example: restoring from bytes
+	blob, _ := collection.New([]int{7, 8}).MarshalBinary()
+
+	var restored collection.Collection[int]
+	_ = restored.UnmarshalBinary(blob)
+	fmt.Println(restored.Items())
+	// [7 8]
+	// Example: restoring numeric data
+	blob2, _ := collection.NewNumeric([]int{1, 2, 3}).MarshalBinary()
+
+	var totals collection.NumericCollection[int]
+	_ = totals.UnmarshalBinary(blob2)
+	fmt.Println(totals.Sum())
+	// 6
+}
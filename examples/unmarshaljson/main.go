@@ -0,0 +1,38 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// UnmarshalJSON decodes a JSON array into the collection, replacing its items.
+	// This method mutates the collection in place.
+
+	// Example: decoding a field
+	type Request struct {
+		IDs *collection.Collection[int] `json:"ids"`
+	}
+
+	var req Request
+	_ = json.Unmarshal([]byte(`{"ids":[3,1,2]}`), &req)
+	collection.Dump(req.IDs.Items())
+	// #[]int [
+	//   0 => 3 #int
+	//   1 => 1 #int
+	//   2 => 2 #int
+	// ]
+	// Example: decoding numeric samples
+	type Metrics struct {
+		Latency collection.NumericCollection[float64] `json:"latency"`
+	}
+
+	var m Metrics
+	_ = json.Unmarshal([]byte(`{"latency":[12.5,7.5]}`), &m)
+	fmt.Println(m.Latency.Sum())
+	// 20
+}
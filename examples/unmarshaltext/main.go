@@ -0,0 +1,32 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/goforj/collection"
+)
+
+func main() {
+	// UnmarshalText decodes JSON array text into the collection, replacing its
+	// items, exactly like UnmarshalJSON.
+	// This method mutates the collection in place.
+
+	// Example: command-line flag
+	fs := flag.NewFlagSet("worker", flag.ContinueOnError)
+	ids := collection.New([]int{})
+	fs.TextVar(ids, "ids", collection.New([]int{1}), "ids to process")
+	_ = fs.Parse([]string{"-ids", "[4,5]"})
+	collection.Dump(ids.Items())
+	// #[]int [
+	//   0 => 4 #int
+	//   1 => 5 #int
+	// ]
+	// Example: numeric text
+	var weights collection.NumericCollection[float64]
+	_ = weights.UnmarshalText([]byte("[0.5,1.5]"))
+	fmt.Println(weights.Sum())
+	// 2
+}
@@ -0,0 +1,33 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"github.com/goforj/collection"
+	"slices"
+)
+
+func main() {
+	// Values returns an iterator over the values in the collection.
+
+	// Example: integers
+	c := collection.New([]int{1, 2, 3})
+	for v := range c.Values() {
+		fmt.Println(v)
+	}
+	// 1
+	// 2
+	// 3
+
+	// Example: interop with slices
+	c2 := collection.New([]string{"banana", "apple", "cherry"})
+	sorted := slices.Sorted(c2.Values())
+	collection.Dump(sorted)
+	// #[]string [
+	//   0 => "apple" #string
+	//   1 => "banana" #string
+	//   2 => "cherry" #string
+	// ]
+}
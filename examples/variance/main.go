@@ -0,0 +1,18 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/goforj/collection"
+
+func main() {
+	// Variance returns the population variance of the collection.
+	// Returns (0, false) if the collection is empty.
+
+	// Example: integers
+	c := collection.NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	v, ok := c.Variance()
+	collection.Dump(v, ok)
+	// 4.000000 #float64
+	// true #bool
+}
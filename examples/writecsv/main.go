@@ -0,0 +1,26 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"encoding/csv"
+	"github.com/goforj/collection"
+	"os"
+)
+
+func main() {
+	// WriteCSV writes the collection to a configured csv.Writer, one record per
+	// item, and flushes it.
+
+	// Example: semicolon-separated
+	cw := csv.NewWriter(os.Stdout)
+	cw.Comma = ';'
+
+	_ = collection.New([]string{"a", "b;c"}).WriteCSV(cw, []string{"value"}, func(s string) []string {
+		return []string{s}
+	})
+	// value
+	// a
+	// "b;c"
+}
@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"github.com/goforj/collection"
+	"os"
+)
+
+func main() {
+	// WriteNDJSON writes the collection to w as newline-delimited JSON (JSON
+	// Lines), one compact JSON value per item.
+
+	// Example: exporting records
+	type Event struct {
+		ID   int    `json:"id"`
+		Kind string `json:"kind"`
+	}
+
+	events := collection.New([]Event{{ID: 1, Kind: "login"}, {ID: 2, Kind: "logout"}})
+	_ = events.WriteNDJSON(os.Stdout)
+	// {"id":1,"kind":"login"}
+	// {"id":2,"kind":"logout"}
+}
@@ -0,0 +1,56 @@
+package collection
+
+// FlatMap maps each item to a slice with fn and concatenates the results
+// into a single Collection[R].
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Results are appended in item order; returning nil or an empty slice drops
+// the item. FlatMap(c, fn) is equivalent to Flatten(MapTo(c, fn)) without the
+// intermediate collection.
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: strings - split into words
+//
+//	lines := collection.New([]string{"go is fun", "", "ship it"})
+//	words := collection.FlatMap(lines, strings.Fields)
+//	collection.Dump(words.Items())
+//	// #[]string [
+//	//   0 => "go" #string
+//	//   1 => "is" #string
+//	//   2 => "fun" #string
+//	//   3 => "ship" #string
+//	//   4 => "it" #string
+//	// ]
+//
+// Example: structs - expand line items
+//
+//	type Order struct {
+//		ID    int
+//		Items []string
+//	}
+//
+//	orders := collection.New([]Order{
+//		{ID: 1, Items: []string{"pen", "ink"}},
+//		{ID: 2, Items: []string{"pad"}},
+//	})
+//
+//	skus := collection.FlatMap(orders, func(o Order) []string {
+//		return o.Items
+//	})
+//	collection.Dump(skus.Items())
+//	// #[]string [
+//	//   0 => "pen" #string
+//	//   1 => "ink" #string
+//	//   2 => "pad" #string
+//	// ]
+func FlatMap[T any, R any](c *Collection[T], fn func(T) []R) *Collection[R] {
+	out := make([]R, 0, len(c.items))
+	for _, v := range c.items {
+		out = append(out, fn(v)...)
+	}
+	return New(out)
+}
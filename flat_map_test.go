@@ -0,0 +1,57 @@
+package collection
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestFlatMap_Expands(t *testing.T) {
+	c := New([]int{1, 2, 3})
+
+	got := FlatMap(c, func(v int) []string {
+		out := make([]string, v)
+		for i := range out {
+			out[i] = strconv.Itoa(v)
+		}
+		return out
+	}).Items()
+
+	expected := []string{"1", "2", "2", "3", "3", "3"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestFlatMap_DropsEmptyResults(t *testing.T) {
+	c := New([]int{1, 2, 3, 4})
+
+	got := FlatMap(c, func(v int) []int {
+		if v%2 == 1 {
+			return nil
+		}
+		return []int{v, -v}
+	}).Items()
+
+	if !reflect.DeepEqual(got, []int{2, -2, 4, -4}) {
+		t.Fatalf("unexpected result: %v", got)
+	}
+}
+
+func TestFlatMap_MatchesFlattenOfMapTo(t *testing.T) {
+	c := New([]string{"ab", "", "cde"})
+	fn := func(s string) []byte { return []byte(s) }
+
+	got := FlatMap(c, fn).Items()
+	want := Flatten(MapTo(c, fn)).Items()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestFlatMap_Empty(t *testing.T) {
+	got := FlatMap(New([]int{}), func(v int) []int { return []int{v} }).Items()
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil items, got %#v", got)
+	}
+}
@@ -0,0 +1,123 @@
+package collection
+
+import "reflect"
+
+// Flatten concatenates a collection of slices into a single collection.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Inner slices are copied in order into a new backing array; nil and empty
+// slices contribute nothing. Flatten undoes Chunk and accepts the output of
+// Window directly.
+//
+// This cannot be a method because a method on Collection[[]T] can't name the
+// element type T of the inner slices.
+//
+// Example: undo Chunk
+//
+//	c := collection.New([]int{1, 2, 3, 4, 5})
+//	flat := collection.Flatten(collection.New(c.Chunk(2)))
+//	collection.Dump(flat.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	//   3 => 4 #int
+//	//   4 => 5 #int
+//	// ]
+//
+// Example: strings
+//
+//	tags := collection.New([][]string{{"go", "api"}, nil, {"db"}})
+//	flat2 := collection.Flatten(tags)
+//	collection.Dump(flat2.Items())
+//	// #[]string [
+//	//   0 => "go" #string
+//	//   1 => "api" #string
+//	//   2 => "db" #string
+//	// ]
+func Flatten[T any](c *Collection[[]T]) *Collection[T] {
+	n := 0
+	for _, inner := range c.items {
+		n += len(inner)
+	}
+
+	out := make([]T, 0, n)
+	for _, inner := range c.items {
+		out = append(out, inner...)
+	}
+	return New(out)
+}
+
+// FlattenDeep flattens nested slices and arrays up to depth levels, returning
+// the leaves as a Collection[any].
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Any item, or nested element, whose kind is slice or array is expanded in
+// place, including []byte; strings, maps, structs and pointers are kept as
+// leaves. A depth of 1 expands only the top-level items, 2 also expands the
+// slices inside them, and so on. If depth <= 0, nesting is flattened
+// completely.
+//
+// Mirrors Laravel's flatten($depth) semantics.
+//
+// Example: unlimited depth
+//
+//	nested := collection.New([]any{1, []any{2, []int{3, 4}}, "five"})
+//	flat := nested.FlattenDeep(0)
+//	collection.Dump(flat.Items())
+//	// #[]interface {} [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	//   3 => 4 #int
+//	//   4 => "five" #string
+//	// ]
+//
+// Example: one level
+//
+//	grid := collection.New([][][]int{{{1, 2}, {3}}, {{4}}})
+//	rows := grid.FlattenDeep(1)
+//	collection.Dump(rows.Items())
+//	// #[]interface {} [
+//	//   0 => #[]int [
+//	//     0 => 1 #int
+//	//     1 => 2 #int
+//	//   ]
+//	//   1 => #[]int [
+//	//     0 => 3 #int
+//	//   ]
+//	//   2 => #[]int [
+//	//     0 => 4 #int
+//	//   ]
+//	// ]
+func (c *Collection[T]) FlattenDeep(depth int) *Collection[any] {
+	if depth <= 0 {
+		depth = -1
+	}
+
+	out := make([]any, 0, len(c.items))
+	for _, v := range c.items {
+		out = flattenValue(out, v, depth)
+	}
+	return New(out)
+}
+
+// flattenValue appends v to out, expanding slices and arrays while depth is
+// non-zero. A negative depth never reaches zero and so expands everything.
+func flattenValue(out []any, v any, depth int) []any {
+	rv := reflect.ValueOf(v)
+	if depth == 0 || !rv.IsValid() || (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) {
+		return append(out, v)
+	}
+
+	for i := range rv.Len() {
+		out = flattenValue(out, rv.Index(i).Interface(), depth-1)
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten_Basic(t *testing.T) {
+	c := New([][]int{{1, 2}, nil, {}, {3}})
+
+	got := Flatten(c).Items()
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestFlatten_RoundTripsChunkAndWindow(t *testing.T) {
+	c := New([]int{1, 2, 3, 4, 5})
+
+	if got := Flatten(New(c.Chunk(2))).Items(); !reflect.DeepEqual(got, c.Items()) {
+		t.Fatalf("expected Flatten to undo Chunk, got %v", got)
+	}
+
+	got := Flatten(Window(c, 2, 2)).Items()
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("expected [1 2 3 4], got %v", got)
+	}
+}
+
+func TestFlatten_DoesNotAlias(t *testing.T) {
+	inner := []int{1, 2}
+	out := Flatten(New([][]int{inner}))
+
+	out.Items()[0] = 99
+	if inner[0] != 1 {
+		t.Fatalf("Flatten should copy into a new backing array")
+	}
+}
+
+func TestFlatten_Empty(t *testing.T) {
+	got := Flatten(New[[]string](nil)).Items()
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil items, got %#v", got)
+	}
+}
+
+func TestFlattenDeep_Depths(t *testing.T) {
+	c := New([]any{1, []any{2, []any{3, []int{4}}}, "five"})
+
+	cases := []struct {
+		depth    int
+		expected []any
+	}{
+		{0, []any{1, 2, 3, 4, "five"}},
+		{-1, []any{1, 2, 3, 4, "five"}},
+		{1, []any{1, 2, []any{3, []int{4}}, "five"}},
+		{2, []any{1, 2, 3, []int{4}, "five"}},
+		{3, []any{1, 2, 3, 4, "five"}},
+		{10, []any{1, 2, 3, 4, "five"}},
+	}
+
+	for _, tc := range cases {
+		got := c.FlattenDeep(tc.depth).Items()
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("depth %d: expected %#v, got %#v", tc.depth, tc.expected, got)
+		}
+	}
+}
+
+func TestFlattenDeep_TypedSlicesAndArrays(t *testing.T) {
+	c := New([][2][]int{{{1}, {2, 3}}, {{}, {4}}})
+
+	got := c.FlattenDeep(0).Items()
+	if !reflect.DeepEqual(got, []any{1, 2, 3, 4}) {
+		t.Fatalf("expected [1 2 3 4], got %#v", got)
+	}
+}
+
+func TestFlattenDeep_KeepsNonSliceLeaves(t *testing.T) {
+	type pt struct{ X int }
+	m := map[string]int{"a": 1}
+	var nilPtr *pt
+
+	c := New([]any{"abc", m, pt{1}, nilPtr, nil, []any{}})
+
+	got := c.FlattenDeep(0).Items()
+	expected := []any{"abc", m, pt{1}, nilPtr, nil}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestFlattenDeep_NonNestedItems(t *testing.T) {
+	got := New([]int{1, 2}).FlattenDeep(0).Items()
+	if !reflect.DeepEqual(got, []any{1, 2}) {
+		t.Fatalf("expected [1 2], got %#v", got)
+	}
+}
@@ -0,0 +1,64 @@
+package collection
+
+// ForPage returns the items that would appear on the given page number,
+// where pages are 1-based and hold perPage items each.
+// @group Slicing
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// A page below 1 is treated as page 1. If perPage <= 0 or the page lies past
+// the end of the collection, an empty collection is returned.
+//
+// Mirrors Laravel's forPage() semantics.
+//
+// NOTE: returns a view (shares backing array). Use Clone() to detach.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+//	out := c.ForPage(2, 3)
+//	collection.Dump(out.Items())
+//	// #[]int [
+//	//   0 => 4 #int
+//	//   1 => 5 #int
+//	//   2 => 6 #int
+//	// ]
+//
+// Example: last partial page
+//
+//	out2 := c.ForPage(4, 2)
+//	collection.Dump(out2.Items())
+//	// #[]int [
+//	//   0 => 7 #int
+//	//   1 => 8 #int
+//	// ]
+//
+// Example: past the end
+//
+//	out3 := c.ForPage(10, 3)
+//	collection.Dump(out3.Items())
+//	// #[]int [
+//	// ]
+func (c *Collection[T]) ForPage(page, perPage int) *Collection[T] {
+	start, end := pageBounds(len(c.items), page, perPage)
+	return New(c.items[start:end])
+}
+
+// pageBounds returns the [start, end) slice bounds of a page, clamped to n.
+func pageBounds(n, page, perPage int) (int, int) {
+	if perPage <= 0 {
+		return 0, 0
+	}
+	if page < 1 {
+		page = 1
+	}
+
+	// Guard against overflow for very large page numbers.
+	if page-1 > (n-1)/perPage {
+		return n, n
+	}
+
+	start := (page - 1) * perPage
+	return start, min(start+perPage, n)
+}
@@ -0,0 +1,52 @@
+package collection
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestForPage_Pages(t *testing.T) {
+	c := New([]int{1, 2, 3, 4, 5, 6, 7})
+
+	cases := []struct {
+		page, perPage int
+		expected      []int
+	}{
+		{1, 3, []int{1, 2, 3}},
+		{2, 3, []int{4, 5, 6}},
+		{3, 3, []int{7}},
+		{4, 3, []int{}},
+		{0, 3, []int{1, 2, 3}},
+		{-5, 2, []int{1, 2}},
+		{1, 10, []int{1, 2, 3, 4, 5, 6, 7}},
+		{1, 0, []int{}},
+		{2, -1, []int{}},
+		{math.MaxInt, 3, []int{}},
+		{2, math.MaxInt, []int{}},
+	}
+
+	for _, tc := range cases {
+		got := c.ForPage(tc.page, tc.perPage).Items()
+		if len(got) != len(tc.expected) || (len(got) > 0 && !reflect.DeepEqual(got, tc.expected)) {
+			t.Fatalf("ForPage(%d, %d): expected %v, got %v", tc.page, tc.perPage, tc.expected, got)
+		}
+	}
+}
+
+func TestForPage_SharesBackingArray(t *testing.T) {
+	c := New([]int{1, 2, 3, 4})
+
+	page := c.ForPage(2, 2)
+	page.Items()[0] = 99
+
+	if c.Items()[2] != 99 {
+		t.Fatalf("expected ForPage to return a view, got %v", c.Items())
+	}
+}
+
+func TestForPage_Empty(t *testing.T) {
+	if got := New([]int{}).ForPage(1, 5).Items(); len(got) != 0 {
+		t.Fatalf("expected empty page, got %v", got)
+	}
+}
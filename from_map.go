@@ -8,6 +8,7 @@ package collection
 //
 // The iteration order of the resulting collection is unspecified,
 // matching Go's map iteration semantics.
+// Use OrderedMap when insertion order must be preserved.
 //
 // This function does not mutate the input map.
 //
@@ -0,0 +1,48 @@
+package collection
+
+// InsertAt inserts the given values before index i, shifting later items
+// to the right.
+// @group Transformation
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// This method mutates the collection in place and returns the same instance.
+// Like Prepend, it allocates a new backing slice, so the slice originally
+// passed to New is left untouched.
+//
+// i is clamped to the collection bounds: i <= 0 inserts at the front and
+// i >= the length appends at the end.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 4})
+//	c.InsertAt(1, 2, 3)
+//	collection.Dump(c.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	//   3 => 4 #int
+//	// ]
+//
+// Example: strings - index past the end appends
+//
+//	letters := collection.New([]string{"a", "b"})
+//	letters.InsertAt(10, "c")
+//	collection.Dump(letters.Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	//   2 => "c" #string
+//	// ]
+func (c *Collection[T]) InsertAt(i int, values ...T) *Collection[T] {
+	i = min(max(i, 0), len(c.items))
+
+	out := make([]T, 0, len(c.items)+len(values))
+	out = append(out, c.items[:i]...)
+	out = append(out, values...)
+	out = append(out, c.items[i:]...)
+	c.items = out
+	return c
+}
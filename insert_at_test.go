@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInsertAt_Positions(t *testing.T) {
+	cases := []struct {
+		i        int
+		expected []int
+	}{
+		{-3, []int{9, 1, 2, 3}},
+		{0, []int{9, 1, 2, 3}},
+		{1, []int{1, 9, 2, 3}},
+		{3, []int{1, 2, 3, 9}},
+		{10, []int{1, 2, 3, 9}},
+	}
+
+	for _, tc := range cases {
+		c := New([]int{1, 2, 3})
+		c.InsertAt(tc.i, 9)
+		if !reflect.DeepEqual(c.Items(), tc.expected) {
+			t.Fatalf("InsertAt(%d): expected %v, got %v", tc.i, tc.expected, c.Items())
+		}
+	}
+}
+
+func TestInsertAt_MultipleValues(t *testing.T) {
+	c := New([]string{"a", "d"})
+
+	out := c.InsertAt(1, "b", "c")
+
+	if out != c {
+		t.Fatalf("expected InsertAt to return same collection instance")
+	}
+	if !reflect.DeepEqual(c.Items(), []string{"a", "b", "c", "d"}) {
+		t.Fatalf("unexpected items: %v", c.Items())
+	}
+}
+
+func TestInsertAt_DoesNotMutateSourceSlice(t *testing.T) {
+	orig := make([]int, 3, 10)
+	copy(orig, []int{1, 2, 3})
+	c := New(orig)
+
+	c.InsertAt(1, 9)
+
+	if !reflect.DeepEqual(orig, []int{1, 2, 3}) || !reflect.DeepEqual(orig[:4], []int{1, 2, 3, 0}) {
+		t.Fatalf("InsertAt mutated source slice: %v", orig[:4])
+	}
+}
+
+func TestInsertAt_NilSlice(t *testing.T) {
+	c := New([]int(nil))
+
+	c.InsertAt(5, 1)
+
+	if !reflect.DeepEqual(c.Items(), []int{1}) {
+		t.Fatalf("expected [1], got %v", c.Items())
+	}
+}
@@ -0,0 +1,24 @@
+package collection
+
+// IQR returns the interquartile range of the collection, the difference
+// between the 75th and 25th percentiles, using the QuantileR7 method.
+// Returns (0, false) if the collection is empty.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	c := collection.NewNumeric([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+//	iqr, ok := c.IQR()
+//	collection.Dump(iqr, ok)
+//	// 4.000000 #float64
+//	// true #bool
+func (c *NumericCollection[T]) IQR() (float64, bool) {
+	qs, ok := c.Quantiles(0.25, 0.75)
+	if !ok {
+		return 0, false
+	}
+	return qs[1] - qs[0], true
+}
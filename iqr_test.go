@@ -0,0 +1,17 @@
+package collection
+
+import "testing"
+
+func TestIQR_Basic(t *testing.T) {
+	iqr, ok := NewNumeric([]int{1, 2, 3, 4, 5, 6, 7, 8, 9}).IQR()
+
+	if !ok || iqr != 4 {
+		t.Fatalf("expected 4, got %v (ok=%v)", iqr, ok)
+	}
+}
+
+func TestIQR_Empty(t *testing.T) {
+	if _, ok := NewNumeric([]float64{}).IQR(); ok {
+		t.Fatalf("expected ok=false for empty collection")
+	}
+}
@@ -0,0 +1,201 @@
+package collection
+
+import "iter"
+
+// Entries returns an iterator over index/value pairs in the collection.
+// @group Access
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Entries is the collection counterpart of slices.All. The name All is
+// already taken by the predicate query, so the index-aware iterator lives here.
+//
+// The iterator reads the backing slice directly; it does not copy.
+// Mutating the collection during iteration is visible to the iterator.
+//
+// Example: integers
+//
+//	c := collection.New([]int{10, 20, 30})
+//	for i, v := range c.Entries() {
+//		fmt.Println(i, v)
+//	}
+//	// 0 10
+//	// 1 20
+//	// 2 30
+//
+// Example: early exit
+//
+//	c2 := collection.New([]string{"a", "b", "c"})
+//	for i, s := range c2.Entries() {
+//		if i == 1 {
+//			break
+//		}
+//		fmt.Println(i, s)
+//	}
+//	// 0 a
+func (c *Collection[T]) Entries() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range c.items {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in the collection.
+// @group Access
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Values is the collection counterpart of slices.Values and can be passed
+// to any iterator-based API such as slices.Collect or slices.Sorted.
+//
+// The iterator reads the backing slice directly; it does not copy.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 3})
+//	for v := range c.Values() {
+//		fmt.Println(v)
+//	}
+//	// 1
+//	// 2
+//	// 3
+//
+// Example: interop with slices
+//
+//	c2 := collection.New([]string{"banana", "apple", "cherry"})
+//	sorted := slices.Sorted(c2.Values())
+//	collection.Dump(sorted)
+//	// #[]string [
+//	//   0 => "apple" #string
+//	//   1 => "banana" #string
+//	//   2 => "cherry" #string
+//	// ]
+func (c *Collection[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range c.items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index/value pairs, traversing the
+// collection from the last item to the first.
+// @group Access
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Indices are the original positions in the collection. Unlike Reverse,
+// Backward does not mutate the collection.
+//
+// Example: integers
+//
+//	c := collection.New([]int{10, 20, 30})
+//	for i, v := range c.Backward() {
+//		fmt.Println(i, v)
+//	}
+//	// 2 30
+//	// 1 20
+//	// 0 10
+func (c *Collection[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(c.items) - 1; i >= 0; i-- {
+			if !yield(i, c.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq creates a new Collection by draining the provided iterator.
+// @group Construction
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// The iterator is consumed exactly once. FromSeq must not be used with
+// infinite iterators.
+//
+// Example: integers
+//
+//	c := collection.FromSeq(slices.Values([]int{1, 2, 3}))
+//	collection.Dump(c.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+//
+// Example: map keys
+//
+//	m := map[string]int{"b": 2, "a": 1, "c": 3}
+//	keys := collection.FromSeq(maps.Keys(m)).
+//		Sort(func(a, b string) bool { return a < b })
+//	collection.Dump(keys.Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	//   2 => "c" #string
+//	// ]
+func FromSeq[T any](seq iter.Seq[T]) *Collection[T] {
+	items := []T{}
+	for v := range seq {
+		items = append(items, v)
+	}
+	return New(items)
+}
+
+// FromSeq2 creates a new Collection of key/value pairs by draining the
+// provided two-value iterator.
+// @group Construction
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Ordering follows the iterator. Iterators over Go maps (such as maps.All)
+// yield pairs in unspecified order, matching FromMap.
+//
+// Example: index/value pairs
+//
+//	c := collection.FromSeq2(slices.All([]string{"a", "b"}))
+//	collection.Dump(c.Items())
+//	// #[]collection.Pair[int,string] [
+//	//   0 => #collection.Pair[int,string] {
+//	//     +Key   => 0 #int
+//	//     +Value => "a" #string
+//	//   }
+//	//   1 => #collection.Pair[int,string] {
+//	//     +Key   => 1 #int
+//	//     +Value => "b" #string
+//	//   }
+//	// ]
+//
+// Example: round trip through another collection
+//
+//	src := collection.New([]int{10, 20})
+//	pairs := collection.FromSeq2(src.Backward())
+//	collection.Dump(pairs.Items())
+//	// #[]collection.Pair[int,int] [
+//	//   0 => #collection.Pair[int,int] {
+//	//     +Key   => 1 #int
+//	//     +Value => 20 #int
+//	//   }
+//	//   1 => #collection.Pair[int,int] {
+//	//     +Key   => 0 #int
+//	//     +Value => 10 #int
+//	//   }
+//	// ]
+func FromSeq2[K comparable, V any](seq iter.Seq2[K, V]) *Collection[Pair[K, V]] {
+	items := []Pair[K, V]{}
+	for k, v := range seq {
+		items = append(items, Pair[K, V]{Key: k, Value: v})
+	}
+	return New(items)
+}
@@ -0,0 +1,161 @@
+package collection
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestEntries_YieldsIndexAndValue(t *testing.T) {
+	c := New([]string{"a", "b", "c"})
+
+	var idx []int
+	var vals []string
+	for i, v := range c.Entries() {
+		idx = append(idx, i)
+		vals = append(vals, v)
+	}
+
+	if !reflect.DeepEqual(idx, []int{0, 1, 2}) {
+		t.Fatalf("unexpected indices: %v", idx)
+	}
+	if !reflect.DeepEqual(vals, []string{"a", "b", "c"}) {
+		t.Fatalf("unexpected values: %v", vals)
+	}
+}
+
+func TestEntries_StopsOnBreak(t *testing.T) {
+	c := New([]int{1, 2, 3, 4})
+
+	count := 0
+	for range c.Entries() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+
+	if count != 2 {
+		t.Fatalf("expected iteration to stop after 2 items, got %d", count)
+	}
+}
+
+func TestValues_CollectsWithSlices(t *testing.T) {
+	c := New([]int{3, 1, 2})
+
+	got := slices.Collect(c.Values())
+
+	if !reflect.DeepEqual(got, []int{3, 1, 2}) {
+		t.Fatalf("expected [3 1 2], got %v", got)
+	}
+}
+
+func TestValues_EmptyCollection(t *testing.T) {
+	c := New([]int{})
+
+	for range c.Values() {
+		t.Fatalf("expected no iterations for empty collection")
+	}
+}
+
+func TestBackward_ReverseOrderWithOriginalIndices(t *testing.T) {
+	c := New([]int{10, 20, 30})
+
+	var idx, vals []int
+	for i, v := range c.Backward() {
+		idx = append(idx, i)
+		vals = append(vals, v)
+	}
+
+	if !reflect.DeepEqual(idx, []int{2, 1, 0}) {
+		t.Fatalf("unexpected indices: %v", idx)
+	}
+	if !reflect.DeepEqual(vals, []int{30, 20, 10}) {
+		t.Fatalf("unexpected values: %v", vals)
+	}
+}
+
+func TestBackward_DoesNotMutate(t *testing.T) {
+	items := []int{1, 2, 3}
+	c := New(items)
+
+	for range c.Backward() {
+	}
+
+	if !reflect.DeepEqual(items, []int{1, 2, 3}) {
+		t.Fatalf("Backward should not mutate the collection, got %v", items)
+	}
+}
+
+func TestFromSeq_Basic(t *testing.T) {
+	c := FromSeq(slices.Values([]string{"x", "y"}))
+
+	if !reflect.DeepEqual(c.Items(), []string{"x", "y"}) {
+		t.Fatalf("expected [x y], got %v", c.Items())
+	}
+}
+
+func TestFromSeq_EmptyIteratorIsNonNil(t *testing.T) {
+	c := FromSeq(slices.Values([]int(nil)))
+
+	if c.Items() == nil || len(c.Items()) != 0 {
+		t.Fatalf("expected empty non-nil items, got %#v", c.Items())
+	}
+}
+
+func TestFromSeq_MapKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	c := FromSeq(maps.Keys(m))
+	got := c.Sort(func(a, b string) bool { return a < b }).Items()
+
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("expected sorted keys [a b c], got %v", got)
+	}
+}
+
+func TestFromSeq_DoesNotBorrowSource(t *testing.T) {
+	src := []int{1, 2, 3}
+	c := FromSeq(slices.Values(src))
+
+	src[0] = 9
+
+	if c.Items()[0] != 1 {
+		t.Fatalf("FromSeq should copy items out of the iterator")
+	}
+}
+
+func TestFromSeq2_Pairs(t *testing.T) {
+	c := FromSeq2(slices.All([]string{"a", "b"}))
+
+	expected := []Pair[int, string]{
+		{Key: 0, Value: "a"},
+		{Key: 1, Value: "b"},
+	}
+
+	if !reflect.DeepEqual(c.Items(), expected) {
+		t.Fatalf("expected %v, got %v", expected, c.Items())
+	}
+}
+
+func TestFromSeq2_MapAll(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	c := FromSeq2(maps.All(m))
+	got := ToMapKV(c)
+
+	if !reflect.DeepEqual(got, m) {
+		t.Fatalf("expected %v, got %v", m, got)
+	}
+}
+
+func TestIter_RoundTrip(t *testing.T) {
+	src := New([]int{1, 2, 3})
+
+	out := FromSeq(src.Values())
+
+	if !reflect.DeepEqual(out.Items(), src.Items()) {
+		t.Fatalf("expected round trip to preserve items, got %v", out.Items())
+	}
+}
@@ -0,0 +1,406 @@
+package collection
+
+import (
+	"cmp"
+	"slices"
+)
+
+// InnerJoin pairs every item in a with every item in b that has the same key.
+// @group Joins
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// InnerJoin is a hash join: b is indexed by keyB once, then a is scanned.
+// Results follow the order of a, and matches for a single item follow the
+// order of b. Items without a match on the other side are dropped.
+//
+// Example: orders with customers
+//
+//	type Customer struct {
+//		ID   int
+//		Name string
+//	}
+//	type Order struct {
+//		ID         int
+//		CustomerID int
+//	}
+//
+//	customers := collection.New([]Customer{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}})
+//	orders := collection.New([]Order{{ID: 10, CustomerID: 2}, {ID: 11, CustomerID: 1}, {ID: 12, CustomerID: 9}})
+//
+//	rows := collection.InnerJoin(orders, customers,
+//		func(o Order) int { return o.CustomerID },
+//		func(c Customer) int { return c.ID },
+//	)
+//
+//	for _, r := range rows.Items() {
+//		fmt.Println(r.First.ID, r.Second.Name)
+//	}
+//	// 10 Bob
+//	// 11 Alice
+func InnerJoin[A any, B any, K comparable](
+	a *Collection[A],
+	b *Collection[B],
+	keyA func(A) K,
+	keyB func(B) K,
+) *Collection[Tuple[A, B]] {
+	index := indexBy(b.items, keyB)
+
+	out := make([]Tuple[A, B], 0, len(a.items))
+	for _, x := range a.items {
+		for _, y := range index[keyA(x)] {
+			out = append(out, Tuple[A, B]{First: x, Second: y})
+		}
+	}
+	return New(out)
+}
+
+// LeftJoin pairs every item in a with every matching item in b, keeping items
+// in a that have no match.
+// @group Joins
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Second is nil for items in a without a match. Otherwise it points to a
+// copy of the matching item from b that belongs to that row alone, even when
+// the same b item matches several rows. Ordering follows InnerJoin.
+//
+// Example: devices with optional owners
+//
+//	type Device struct {
+//		Name    string
+//		OwnerID int
+//	}
+//	type Owner struct {
+//		ID   int
+//		Name string
+//	}
+//
+//	devices := collection.New([]Device{{Name: "r1", OwnerID: 1}, {Name: "r2", OwnerID: 5}})
+//	owners := collection.New([]Owner{{ID: 1, Name: "ops"}})
+//
+//	rows := collection.LeftJoin(devices, owners,
+//		func(d Device) int { return d.OwnerID },
+//		func(o Owner) int { return o.ID },
+//	)
+//
+//	for _, r := range rows.Items() {
+//		owner := "<none>"
+//		if r.Second != nil {
+//			owner = r.Second.Name
+//		}
+//		fmt.Println(r.First.Name, owner)
+//	}
+//	// r1 ops
+//	// r2 <none>
+func LeftJoin[A any, B any, K comparable](
+	a *Collection[A],
+	b *Collection[B],
+	keyA func(A) K,
+	keyB func(B) K,
+) *Collection[Tuple[A, *B]] {
+	index := indexBy(b.items, keyB)
+
+	out := make([]Tuple[A, *B], 0, len(a.items))
+	for _, x := range a.items {
+		matches := index[keyA(x)]
+		if len(matches) == 0 {
+			out = append(out, Tuple[A, *B]{First: x})
+			continue
+		}
+		for _, y := range matches {
+			out = append(out, Tuple[A, *B]{First: x, Second: &y})
+		}
+	}
+	return New(out)
+}
+
+// FullOuterJoin pairs matching items from a and b, keeping unmatched items
+// from both sides.
+// @group Joins
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Rows for a come first, in the order of LeftJoin, with Second nil when an
+// item has no match. They are followed by the unmatched items of b, in b's
+// order, with First nil. Non-nil fields point to copies of the source items,
+// and every row has its own copies, so modifying one row never affects
+// another.
+//
+// Example: reconciling two inventories
+//
+//	left := collection.New([]string{"apple", "pear"})
+//	right := collection.New([]string{"pear", "plum"})
+//	id := func(s string) string { return s }
+//
+//	for _, r := range collection.FullOuterJoin(left, right, id, id).Items() {
+//		l, rr := "-", "-"
+//		if r.First != nil {
+//			l = *r.First
+//		}
+//		if r.Second != nil {
+//			rr = *r.Second
+//		}
+//		fmt.Println(l, rr)
+//	}
+//	// apple -
+//	// pear pear
+//	// - plum
+func FullOuterJoin[A any, B any, K comparable](
+	a *Collection[A],
+	b *Collection[B],
+	keyA func(A) K,
+	keyB func(B) K,
+) *Collection[Tuple[*A, *B]] {
+	index := indexBy(b.items, keyB)
+	matched := make(map[K]struct{}, len(index))
+
+	out := make([]Tuple[*A, *B], 0, len(a.items))
+	for _, x := range a.items {
+		k := keyA(x)
+		matches := index[k]
+		if len(matches) == 0 {
+			out = append(out, Tuple[*A, *B]{First: &x})
+			continue
+		}
+		matched[k] = struct{}{}
+		for _, y := range matches {
+			// Copy x per row so rows for the same item don't share a pointer.
+			xc := x
+			out = append(out, Tuple[*A, *B]{First: &xc, Second: &y})
+		}
+	}
+
+	for _, y := range b.items {
+		if _, ok := matched[keyB(y)]; !ok {
+			out = append(out, Tuple[*A, *B]{Second: &y})
+		}
+	}
+	return New(out)
+}
+
+// GroupJoin pairs every item in a with all matching items from b.
+// @group Joins
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Every item in a appears exactly once, in order. Second holds its matches in
+// b's order, or an empty slice if there are none. Each row gets its own
+// slice, so appending to one group never affects another.
+//
+// Example: customers with their orders
+//
+//	type Customer struct {
+//		ID   int
+//		Name string
+//	}
+//	type Order struct {
+//		ID         int
+//		CustomerID int
+//	}
+//
+//	customers := collection.New([]Customer{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}})
+//	orders := collection.New([]Order{{ID: 10, CustomerID: 1}, {ID: 11, CustomerID: 1}})
+//
+//	groups := collection.GroupJoin(customers, orders,
+//		func(c Customer) int { return c.ID },
+//		func(o Order) int { return o.CustomerID },
+//	)
+//
+//	for _, g := range groups.Items() {
+//		fmt.Println(g.First.Name, len(g.Second))
+//	}
+//	// Alice 2
+//	// Bob 0
+func GroupJoin[A any, B any, K comparable](
+	a *Collection[A],
+	b *Collection[B],
+	keyA func(A) K,
+	keyB func(B) K,
+) *Collection[Tuple[A, []B]] {
+	index := indexBy(b.items, keyB)
+
+	out := make([]Tuple[A, []B], len(a.items))
+	for i, x := range a.items {
+		matches := slices.Clone(index[keyA(x)])
+		if matches == nil {
+			matches = []B{}
+		}
+		out[i] = Tuple[A, []B]{First: x, Second: matches}
+	}
+	return New(out)
+}
+
+// SemiJoin returns a new collection with the items in a that have at least
+// one match in b.
+// @group Joins
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Each item in a appears at most once, in its original order, however many
+// matches it has.
+//
+// Example: users with at least one login
+//
+//	type Login struct{ UserID int }
+//
+//	users := collection.New([]int{1, 2, 3})
+//	logins := collection.New([]Login{{UserID: 3}, {UserID: 1}, {UserID: 3}})
+//
+//	active := collection.SemiJoin(users, logins,
+//		func(id int) int { return id },
+//		func(l Login) int { return l.UserID },
+//	)
+//	collection.Dump(active.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 3 #int
+//	// ]
+func SemiJoin[A any, B any, K comparable](
+	a *Collection[A],
+	b *Collection[B],
+	keyA func(A) K,
+	keyB func(B) K,
+) *Collection[A] {
+	return filterByKeys(a, b, keyA, keyB, true)
+}
+
+// AntiJoin returns a new collection with the items in a that have no match
+// in b.
+// @group Joins
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Items keep their original order.
+//
+// Example: users who never logged in
+//
+//	type Login struct{ UserID int }
+//
+//	users := collection.New([]int{1, 2, 3})
+//	logins := collection.New([]Login{{UserID: 3}, {UserID: 1}})
+//
+//	inactive := collection.AntiJoin(users, logins,
+//		func(id int) int { return id },
+//		func(l Login) int { return l.UserID },
+//	)
+//	collection.Dump(inactive.Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	// ]
+func AntiJoin[A any, B any, K comparable](
+	a *Collection[A],
+	b *Collection[B],
+	keyA func(A) K,
+	keyB func(B) K,
+) *Collection[A] {
+	return filterByKeys(a, b, keyA, keyB, false)
+}
+
+// MergeJoin is a sort-merge inner join for inputs that are already sorted
+// ascending by key.
+// @group Joins
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// MergeJoin produces the same rows as InnerJoin but walks both collections
+// once without building a hash index, which saves memory on large, pre-sorted
+// inputs. If either input is not sorted by its key, the result is incomplete.
+// Keys are compared with cmp.Compare.
+//
+// Example: joining sorted time buckets
+//
+//	type Reading struct {
+//		Minute int
+//		Value  float64
+//	}
+//	type Alert struct {
+//		Minute int
+//		Msg    string
+//	}
+//
+//	readings := collection.New([]Reading{{1, 0.2}, {2, 0.9}, {3, 0.4}})
+//	alerts := collection.New([]Alert{{2, "spike"}, {4, "late"}})
+//
+//	rows := collection.MergeJoin(readings, alerts,
+//		func(r Reading) int { return r.Minute },
+//		func(a Alert) int { return a.Minute },
+//	)
+//
+//	for _, r := range rows.Items() {
+//		fmt.Println(r.First.Minute, r.First.Value, r.Second.Msg)
+//	}
+//	// 2 0.9 spike
+func MergeJoin[A any, B any, K cmp.Ordered](
+	a *Collection[A],
+	b *Collection[B],
+	keyA func(A) K,
+	keyB func(B) K,
+) *Collection[Tuple[A, B]] {
+	as, bs := a.items, b.items
+	out := []Tuple[A, B]{}
+
+	i, j := 0, 0
+	for i < len(as) && j < len(bs) {
+		ka, kb := keyA(as[i]), keyB(bs[j])
+		switch c := cmp.Compare(ka, kb); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			// Find the run of equal keys in b, then pair it with every
+			// item in a's run.
+			end := j + 1
+			for end < len(bs) && cmp.Compare(keyB(bs[end]), ka) == 0 {
+				end++
+			}
+			for ; i < len(as) && cmp.Compare(keyA(as[i]), ka) == 0; i++ {
+				for _, y := range bs[j:end] {
+					out = append(out, Tuple[A, B]{First: as[i], Second: y})
+				}
+			}
+			j = end
+		}
+	}
+	return New(out)
+}
+
+// indexBy groups items by key, preserving their relative order.
+func indexBy[T any, K comparable](items []T, keyFn func(T) K) map[K][]T {
+	index := make(map[K][]T, len(items))
+	for _, v := range items {
+		k := keyFn(v)
+		index[k] = append(index[k], v)
+	}
+	return index
+}
+
+// filterByKeys keeps the items in a whose key is (or is not) present in b.
+func filterByKeys[A any, B any, K comparable](
+	a *Collection[A],
+	b *Collection[B],
+	keyA func(A) K,
+	keyB func(B) K,
+	present bool,
+) *Collection[A] {
+	keys := make(map[K]struct{}, len(b.items))
+	for _, y := range b.items {
+		keys[keyB(y)] = struct{}{}
+	}
+
+	out := make([]A, 0, len(a.items))
+	for _, x := range a.items {
+		if _, ok := keys[keyA(x)]; ok == present {
+			out = append(out, x)
+		}
+	}
+	return New(out)
+}
@@ -0,0 +1,230 @@
+package collection
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type joinUser struct {
+	ID   int
+	Name string
+}
+
+type joinOrder struct {
+	ID     int
+	UserID int
+}
+
+func joinFixtures() (*Collection[joinUser], *Collection[joinOrder]) {
+	users := New([]joinUser{{1, "alice"}, {2, "bob"}, {3, "carol"}})
+	orders := New([]joinOrder{{10, 1}, {11, 3}, {12, 1}, {13, 9}})
+	return users, orders
+}
+
+func userID(u joinUser) int     { return u.ID }
+func orderUser(o joinOrder) int { return o.UserID }
+
+func TestInnerJoin_OrderAndDuplicates(t *testing.T) {
+	users, orders := joinFixtures()
+
+	rows := InnerJoin(users, orders, userID, orderUser)
+
+	var got [][2]int
+	for _, r := range rows.Items() {
+		got = append(got, [2]int{r.First.ID, r.Second.ID})
+	}
+	expected := [][2]int{{1, 10}, {1, 12}, {3, 11}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestInnerJoin_EmptySide(t *testing.T) {
+	users, _ := joinFixtures()
+
+	rows := InnerJoin(users, New([]joinOrder{}), userID, orderUser)
+
+	if rows.Items() == nil || len(rows.Items()) != 0 {
+		t.Fatalf("expected empty non-nil result, got %#v", rows.Items())
+	}
+}
+
+func TestLeftJoin_KeepsUnmatched(t *testing.T) {
+	users, orders := joinFixtures()
+
+	rows := LeftJoin(users, orders, userID, orderUser).Items()
+
+	if len(rows) != 4 {
+		t.Fatalf("expected 4 rows, got %d", len(rows))
+	}
+	if rows[2].First.ID != 2 || rows[2].Second != nil {
+		t.Fatalf("expected bob with nil match, got %+v", rows[2])
+	}
+	if rows[0].Second.ID != 10 || rows[1].Second.ID != 12 {
+		t.Fatalf("unexpected matches for alice: %v, %v", rows[0].Second, rows[1].Second)
+	}
+}
+
+func TestLeftJoin_PointersAreCopies(t *testing.T) {
+	users, orders := joinFixtures()
+
+	rows := LeftJoin(users, orders, userID, orderUser).Items()
+	rows[0].Second.ID = 999
+
+	if orders.Items()[0].ID != 10 {
+		t.Fatalf("modifying a joined row should not affect the source")
+	}
+	if rows[1].Second == rows[0].Second {
+		t.Fatalf("each match should have its own copy")
+	}
+}
+
+func TestFullOuterJoin_BothSides(t *testing.T) {
+	users, orders := joinFixtures()
+
+	rows := FullOuterJoin(users, orders, userID, orderUser).Items()
+
+	type row struct{ user, order int }
+	var got []row
+	for _, r := range rows {
+		var x row
+		if r.First != nil {
+			x.user = r.First.ID
+		}
+		if r.Second != nil {
+			x.order = r.Second.ID
+		}
+		got = append(got, x)
+	}
+
+	expected := []row{{1, 10}, {1, 12}, {2, 0}, {3, 11}, {0, 13}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestGroupJoin_EveryLeftItemOnce(t *testing.T) {
+	users, orders := joinFixtures()
+
+	groups := GroupJoin(users, orders, userID, orderUser).Items()
+
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %d", len(groups))
+	}
+	if len(groups[0].Second) != 2 || groups[0].Second[1].ID != 12 {
+		t.Fatalf("unexpected alice group: %v", groups[0].Second)
+	}
+	if groups[1].Second == nil || len(groups[1].Second) != 0 {
+		t.Fatalf("expected empty non-nil group for bob, got %#v", groups[1].Second)
+	}
+}
+
+func TestGroupJoin_EqualKeysGetIndependentSlices(t *testing.T) {
+	id := func(v int) int { return v }
+	g := GroupJoin(New([]int{1, 1}), New([]int{1, 1, 1}), id, id).Items()
+
+	g[0].Second = append(g[0].Second, 100)
+	g[1].Second = append(g[1].Second, 200)
+	g[0].Second[0] = -1
+
+	if !reflect.DeepEqual(g[0].Second, []int{-1, 1, 1, 100}) {
+		t.Fatalf("unexpected first group: %v", g[0].Second)
+	}
+	if !reflect.DeepEqual(g[1].Second, []int{1, 1, 1, 200}) {
+		t.Fatalf("unexpected second group: %v", g[1].Second)
+	}
+}
+
+func TestSemiJoinAndAntiJoin(t *testing.T) {
+	users, orders := joinFixtures()
+
+	semi := SemiJoin(users, orders, userID, orderUser).Items()
+	anti := AntiJoin(users, orders, userID, orderUser).Items()
+
+	if !reflect.DeepEqual(semi, []joinUser{{1, "alice"}, {3, "carol"}}) {
+		t.Fatalf("unexpected semi join: %v", semi)
+	}
+	if !reflect.DeepEqual(anti, []joinUser{{2, "bob"}}) {
+		t.Fatalf("unexpected anti join: %v", anti)
+	}
+}
+
+func TestMergeJoin_MatchesInnerJoin(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+
+	left := make([]Tuple[int, int], 200)
+	for i := range left {
+		left[i] = Tuple[int, int]{First: r.Intn(40), Second: i}
+	}
+	right := make([]Tuple[int, int], 150)
+	for i := range right {
+		right[i] = Tuple[int, int]{First: r.Intn(40), Second: i}
+	}
+	byFirst := func(x, y Tuple[int, int]) int { return x.First - y.First }
+	slices.SortStableFunc(left, byFirst)
+	slices.SortStableFunc(right, byFirst)
+
+	key := func(x Tuple[int, int]) int { return x.First }
+	a, b := New(left), New(right)
+
+	hash := InnerJoin(a, b, key, key).Items()
+	merge := MergeJoin(a, b, key, key).Items()
+
+	if !reflect.DeepEqual(hash, merge) {
+		t.Fatalf("merge join differs from hash join: %d vs %d rows", len(merge), len(hash))
+	}
+}
+
+func TestMergeJoin_Empty(t *testing.T) {
+	id := func(v int) int { return v }
+
+	out := MergeJoin(New([]int{}), New([]int{1}), id, id)
+
+	if out.Items() == nil || len(out.Items()) != 0 {
+		t.Fatalf("expected empty non-nil result, got %#v", out.Items())
+	}
+}
+
+func TestLeftJoin_RowsDoNotSharePointers(t *testing.T) {
+	type A struct{ K int }
+	type B struct {
+		K int
+		V string
+	}
+
+	a := New([]A{{1}, {1}})
+	b := New([]B{{1, "x"}})
+
+	rows := LeftJoin(a, b, func(x A) int { return x.K }, func(y B) int { return y.K }).Items()
+	rows[0].Second.V = "changed"
+
+	if rows[1].Second.V != "x" || b.Items()[0].V != "x" {
+		t.Fatalf("expected each row to own its copy of the b item")
+	}
+}
+
+func TestFullOuterJoin_RowsDoNotSharePointers(t *testing.T) {
+	type A struct {
+		K int
+		V string
+	}
+	type B struct{ K int }
+
+	a := New([]A{{1, "a"}})
+	b := New([]B{{1}, {1}})
+
+	rows := FullOuterJoin(a, b, func(x A) int { return x.K }, func(y B) int { return y.K }).Items()
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	rows[0].First.V = "changed"
+	if rows[1].First.V != "a" || a.Items()[0].V != "a" {
+		t.Fatalf("expected each row to own its copy of the a item")
+	}
+	if rows[0].Second == rows[1].Second {
+		t.Fatalf("expected distinct b copies per row")
+	}
+}
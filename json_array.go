@@ -0,0 +1,131 @@
+package collection
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// FromJSON decodes a top-level JSON array from r into a new collection.
+// @group Serialization
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// Elements are decoded one at a time with ReadJSONArray, so only the
+// resulting items are held in memory, not the raw input. A top-level null
+// yields an empty collection. FromJSON stops at the first error and returns
+// the items decoded so far; element failures are reported as *ItemError
+// with the element's index.
+//
+// Example: loading an array
+//
+//	type User struct {
+//		ID   int    `json:"id"`
+//		Name string `json:"name"`
+//	}
+//
+//	users, err := collection.FromJSON[User](strings.NewReader(`[{"id":1,"name":"ann"},{"id":2,"name":"bob"}]`))
+//	collection.Dump(users.Items())
+//	fmt.Println(err)
+//	// #[]main.User [
+//	//   0 => #main.User {
+//	//     +ID   => 1 #int
+//	//     +Name => "ann" #string
+//	//   }
+//	//   1 => #main.User {
+//	//     +ID   => 2 #int
+//	//     +Name => "bob" #string
+//	//   }
+//	// ]
+//	// <nil>
+func FromJSON[T any](r io.Reader) (*Collection[T], error) {
+	items := []T{}
+	for v, err := range ReadJSONArray[T](r) {
+		if err != nil {
+			return New(items), err
+		}
+		items = append(items, v)
+	}
+	return New(items), nil
+}
+
+// ReadJSONArray streams the elements of a top-level JSON array from r,
+// yielding each decoded element or an error.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The array is read with json.Decoder tokens, one element at a time, so
+// arbitrarily large arrays can be processed in constant memory. A top-level
+// null yields nothing.
+//
+// If an element is valid JSON but does not fit T, a zero value is yielded
+// with an *ItemError carrying the element's index, and iteration continues.
+// Syntax errors, I/O errors and a top-level value that is not an array are
+// yielded once and end the sequence.
+//
+// Example: streaming a large export
+//
+//	input := `[{"id":1,"total":9.5},{"id":2,"total":"n/a"},{"id":3,"total":4}]`
+//
+//	type Order struct {
+//		ID    int     `json:"id"`
+//		Total float64 `json:"total"`
+//	}
+//
+//	sum := 0.0
+//	for o, err := range collection.ReadJSONArray[Order](strings.NewReader(input)) {
+//		if err != nil {
+//			fmt.Println("skip:", err)
+//			continue
+//		}
+//		sum += o.Total
+//	}
+//	fmt.Println(sum)
+//	// skip: item 1: json: cannot unmarshal string into Go struct field Order.total of type float64
+//	// 13.5
+func ReadJSONArray[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		dec := json.NewDecoder(r)
+
+		tok, err := dec.Token()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		if tok == nil {
+			return
+		}
+		if d, ok := tok.(json.Delim); !ok || d != '[' {
+			yield(zero, fmt.Errorf("collection: expected JSON array, got %v", tok))
+			return
+		}
+
+		for i := 0; dec.More(); i++ {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				var te *json.UnmarshalTypeError
+				if !errors.As(err, &te) {
+					yield(zero, &ItemError[T]{Index: i, Err: err})
+					return
+				}
+				if !yield(zero, &ItemError[T]{Index: i, Err: err}) {
+					return
+				}
+				continue
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+
+		if _, err := dec.Token(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromJSON_Array(t *testing.T) {
+	out, err := FromJSON[int](strings.NewReader(" [1, 2, 3] "))
+
+	if err != nil || !reflect.DeepEqual(out.Items(), []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v, %v", out.Items(), err)
+	}
+}
+
+func TestFromJSON_EmptyAndNull(t *testing.T) {
+	for _, in := range []string{"[]", "null"} {
+		out, err := FromJSON[int](strings.NewReader(in))
+		if err != nil || out.Items() == nil || len(out.Items()) != 0 {
+			t.Fatalf("%s: expected empty non-nil result, got %#v, %v", in, out.Items(), err)
+		}
+	}
+}
+
+func TestFromJSON_NotAnArray(t *testing.T) {
+	if _, err := FromJSON[int](strings.NewReader(`{"a":1}`)); err == nil {
+		t.Fatalf("expected error for non-array input")
+	}
+}
+
+func TestFromJSON_TypeErrorHasIndex(t *testing.T) {
+	out, err := FromJSON[int](strings.NewReader(`[1, "two", 3]`))
+
+	var ie *ItemError[int]
+	if !errors.As(err, &ie) || ie.Index != 1 {
+		t.Fatalf("expected ItemError at index 1, got %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), []int{1}) {
+		t.Fatalf("expected partial [1], got %v", out.Items())
+	}
+}
+
+func TestReadJSONArray_ContinuesPastTypeErrors(t *testing.T) {
+	var got []int
+	var failed []int
+	for v, err := range ReadJSONArray[int](strings.NewReader(`[1, "x", {"y":1}, 4]`)) {
+		if err != nil {
+			var ie *ItemError[int]
+			if !errors.As(err, &ie) {
+				t.Fatalf("expected ItemError, got %v", err)
+			}
+			failed = append(failed, ie.Index)
+			continue
+		}
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{1, 4}) || !reflect.DeepEqual(failed, []int{1, 2}) {
+		t.Fatalf("unexpected results: got %v, failed %v", got, failed)
+	}
+}
+
+func TestReadJSONArray_SyntaxErrorEndsSequence(t *testing.T) {
+	var got []int
+	errs := 0
+	for v, err := range ReadJSONArray[int](strings.NewReader(`[1, 2, }`)) {
+		if err != nil {
+			errs++
+			continue
+		}
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{1, 2}) || errs != 1 {
+		t.Fatalf("expected [1 2] and one error, got %v and %d errors", got, errs)
+	}
+}
+
+func TestReadJSONArray_Truncated(t *testing.T) {
+	_, err := FromJSON[int](strings.NewReader(`[1, 2`))
+
+	if err == nil {
+		t.Fatalf("expected error for truncated input")
+	}
+}
@@ -0,0 +1,182 @@
+package collection
+
+import "iter"
+
+// LazyCollection is a deferred, single-pass pipeline over a sequence of T.
+//
+// Stages such as Filter, Map, Skip and Take do no work when called; they
+// compose into a single fused iterator that runs only when a terminal
+// operation (Collect, First, Reduce, Count, Each) pulls values through it.
+// Each item flows through every stage before the next item is read, so
+// pipelines short-circuit as soon as a terminal operation has enough data.
+//
+// Every terminal operation re-evaluates the pipeline from its source.
+type LazyCollection[T any] struct {
+	seq iter.Seq[T]
+}
+
+// NewLazy creates a LazyCollection over the provided slice and borrows it.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// The slice is read when a terminal operation runs, not when NewLazy is called.
+//
+// Example: integers
+//
+//	lc := collection.NewLazy([]int{1, 2, 3, 4, 5, 6})
+//	out := lc.
+//		Filter(func(v int) bool { return v%2 == 0 }).
+//		Take(2).
+//		Collect()
+//	collection.Dump(out.Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 4 #int
+//	// ]
+func NewLazy[T any](items []T) *LazyCollection[T] {
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// LazyFromSeq creates a LazyCollection over the provided iterator.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Any func(yield func(T) bool) can be used as a generator, including
+// infinite ones, as long as the pipeline is bounded by Take or TakeUntilFn.
+//
+// Example: generator function
+//
+//	lc := collection.LazyFromSeq(func(yield func(string) bool) {
+//		for _, s := range []string{"a", "b", "c"} {
+//			if !yield(s) {
+//				return
+//			}
+//		}
+//	})
+//	collection.Dump(lc.Collect().Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	//   2 => "c" #string
+//	// ]
+//
+// Example: stdlib iterator
+//
+//	lc2 := collection.LazyFromSeq(slices.Values([]int{3, 1, 2}))
+//	collection.Dump(lc2.Count())
+//	// 3 #int
+func LazyFromSeq[T any](seq iter.Seq[T]) *LazyCollection[T] {
+	return &LazyCollection[T]{seq: seq}
+}
+
+// LazyGenerate creates an infinite LazyCollection by calling fn(i) for
+// i = 0, 1, 2, ...
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// The sequence never ends on its own; bound it with Take or TakeUntilFn
+// before calling a terminal operation that consumes every item.
+//
+// Example: first five squares
+//
+//	squares := collection.LazyGenerate(func(i int) int { return i * i }).
+//		Take(5).
+//		Collect()
+//	collection.Dump(squares.Items())
+//	// #[]int [
+//	//   0 => 0 #int
+//	//   1 => 1 #int
+//	//   2 => 4 #int
+//	//   3 => 9 #int
+//	//   4 => 16 #int
+//	// ]
+func LazyGenerate[T any](fn func(i int) T) *LazyCollection[T] {
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		for i := 0; ; i++ {
+			if !yield(fn(i)) {
+				return
+			}
+		}
+	}}
+}
+
+// Lazy returns a LazyCollection over the collection's items.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// The backing slice is borrowed and read when a terminal operation runs.
+// Lazy stages never mutate the source collection.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 3, 4})
+//	first, ok := c.Lazy().
+//		Filter(func(v int) bool { return v > 2 }).
+//		First()
+//	collection.Dump(first, ok)
+//	// 3 #int
+//	// true #bool
+func (c *Collection[T]) Lazy() *LazyCollection[T] {
+	return &LazyCollection[T]{seq: c.Values()}
+}
+
+// Values returns the pipeline as an iterator.
+// @group Lazy
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Ranging over the iterator drives the pipeline; breaking out of the loop
+// stops all upstream stages.
+//
+// Example: integers
+//
+//	lc := collection.NewLazy([]int{1, 2, 3}).
+//		Map(func(v int) int { return v * 10 })
+//	for v := range lc.Values() {
+//		fmt.Println(v)
+//	}
+//	// 10
+//	// 20
+//	// 30
+func (l *LazyCollection[T]) Values() iter.Seq[T] {
+	return l.seq
+}
+
+// Collect runs the pipeline and materializes the results into a Collection.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Collect allocates a new backing slice. It must not be used on an
+// unbounded pipeline.
+//
+// Example: integers
+//
+//	out := collection.NewLazy([]int{1, 2, 3}).
+//		Map(func(v int) int { return v + 1 }).
+//		Collect()
+//	collection.Dump(out.Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 3 #int
+//	//   2 => 4 #int
+//	// ]
+func (l *LazyCollection[T]) Collect() *Collection[T] {
+	return FromSeq(l.seq)
+}
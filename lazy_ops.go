@@ -0,0 +1,297 @@
+package collection
+
+import "iter"
+
+// Filter adds a deferred stage that keeps only items for which fn returns true.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// fn is not called until a terminal operation runs, and only for as many
+// items as the rest of the pipeline requests.
+//
+// Example: integers
+//
+//	calls := 0
+//	out := collection.NewLazy([]int{1, 2, 3, 4, 5, 6, 7, 8}).
+//		Filter(func(v int) bool {
+//			calls++
+//			return v%2 == 0
+//		}).
+//		Take(2).
+//		Collect()
+//	collection.Dump(out.Items(), calls)
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 4 #int
+//	// ]
+//	// 4 #int
+func (l *LazyCollection[T]) Filter(fn func(T) bool) *LazyCollection[T] {
+	src := l.seq
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		for v := range src {
+			if fn(v) && !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Map adds a deferred same-type transformation stage.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Unlike Collection.Map, this never mutates the source.
+// Use LazyMapTo to change the element type.
+//
+// Example: integers
+//
+//	out := collection.NewLazy([]int{1, 2, 3}).
+//		Map(func(v int) int { return v * 2 }).
+//		Collect()
+//	collection.Dump(out.Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 4 #int
+//	//   2 => 6 #int
+//	// ]
+func (l *LazyCollection[T]) Map(fn func(T) T) *LazyCollection[T] {
+	src := l.seq
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		for v := range src {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}}
+}
+
+// Skip adds a deferred stage that drops the first n items.
+// If n is less than or equal to zero, every item passes through.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	out := collection.NewLazy([]int{1, 2, 3, 4, 5}).
+//		Skip(3).
+//		Collect()
+//	collection.Dump(out.Items())
+//	// #[]int [
+//	//   0 => 4 #int
+//	//   1 => 5 #int
+//	// ]
+func (l *LazyCollection[T]) Skip(n int) *LazyCollection[T] {
+	src := l.seq
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		skipped := 0
+		for v := range src {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Take adds a deferred stage that yields the first n items when n > 0,
+// or the last |n| items when n < 0.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// With n > 0 the source is not read past the n-th item, which makes Take
+// the usual way to bound an infinite pipeline.
+// With n < 0 the whole source is consumed and the last |n| items are
+// buffered, mirroring Collection.Take.
+// If n == 0, nothing is yielded and the source is never read.
+//
+// Example: integers - first 3
+//
+//	out := collection.LazyGenerate(func(i int) int { return i + 1 }).
+//		Take(3).
+//		Collect()
+//	collection.Dump(out.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+//
+// Example: integers - last 2 (negative n)
+//
+//	out2 := collection.NewLazy([]int{1, 2, 3, 4, 5}).
+//		Take(-2).
+//		Collect()
+//	collection.Dump(out2.Items())
+//	// #[]int [
+//	//   0 => 4 #int
+//	//   1 => 5 #int
+//	// ]
+func (l *LazyCollection[T]) Take(n int) *LazyCollection[T] {
+	src := l.seq
+
+	if n < 0 {
+		return &LazyCollection[T]{seq: func(yield func(T) bool) {
+			for _, v := range takeLastSeq(src, -n) {
+				if !yield(v) {
+					return
+				}
+			}
+		}}
+	}
+
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+		taken := 0
+		for v := range src {
+			if !yield(v) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}}
+}
+
+// takeLastSeq drains seq and returns its last n items in order using a
+// ring buffer of size n.
+func takeLastSeq[T any](seq iter.Seq[T], n int) []T {
+	ring := make([]T, 0, n)
+	start := 0
+	for v := range seq {
+		if len(ring) < n {
+			ring = append(ring, v)
+			continue
+		}
+		ring[start] = v
+		start = (start + 1) % n
+	}
+
+	out := make([]T, 0, len(ring))
+	out = append(out, ring[start:]...)
+	out = append(out, ring[:start]...)
+	return out
+}
+
+// TakeUntilFn adds a deferred stage that yields items until pred returns true.
+// The matching item is NOT included, and the source is not read further.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Example: integers - stop at first value >= 3
+//
+//	out := collection.LazyGenerate(func(i int) int { return i }).
+//		TakeUntilFn(func(v int) bool { return v >= 3 }).
+//		Collect()
+//	collection.Dump(out.Items())
+//	// #[]int [
+//	//   0 => 0 #int
+//	//   1 => 1 #int
+//	//   2 => 2 #int
+//	// ]
+func (l *LazyCollection[T]) TakeUntilFn(pred func(T) bool) *LazyCollection[T] {
+	src := l.seq
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		for v := range src {
+			if pred(v) || !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// LazyMapTo adds a deferred stage that maps each item from T to R.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: integers to strings
+//
+//	lc := collection.NewLazy([]int{1, 2, 3})
+//	labels := collection.LazyMapTo(lc, func(v int) string {
+//		return fmt.Sprintf("#%d", v)
+//	}).Collect()
+//	collection.Dump(labels.Items())
+//	// #[]string [
+//	//   0 => "#1" #string
+//	//   1 => "#2" #string
+//	//   2 => "#3" #string
+//	// ]
+func LazyMapTo[T any, R any](l *LazyCollection[T], fn func(T) R) *LazyCollection[R] {
+	src := l.seq
+	return &LazyCollection[R]{seq: func(yield func(R) bool) {
+		for v := range src {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}}
+}
+
+// LazyChunk adds a deferred stage that groups items into slices of the given size.
+// The final chunk may be smaller. If size <= 0, nothing is yielded.
+// @group Lazy
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Each chunk is a freshly allocated slice, so chunks remain valid after
+// the pipeline advances.
+// This cannot be a method because the element type changes to []T.
+//
+// Example: integers
+//
+//	lc := collection.LazyGenerate(func(i int) int { return i + 1 })
+//	chunks := collection.LazyChunk(lc, 2).Take(2).Collect()
+//	collection.Dump(chunks.Items())
+//	// #[][]int [
+//	//   0 => #[]int [
+//	//     0 => 1 #int
+//	//     1 => 2 #int
+//	//   ]
+//	//   1 => #[]int [
+//	//     0 => 3 #int
+//	//     1 => 4 #int
+//	//   ]
+//	// ]
+func LazyChunk[T any](l *LazyCollection[T], size int) *LazyCollection[[]T] {
+	src := l.seq
+	return &LazyCollection[[]T]{seq: func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		chunk := make([]T, 0, size)
+		for v := range src {
+			chunk = append(chunk, v)
+			if len(chunk) < size {
+				continue
+			}
+			if !yield(chunk) {
+				return
+			}
+			chunk = make([]T, 0, size)
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}}
+}
@@ -0,0 +1,103 @@
+package collection
+
+// First runs the pipeline until it yields one item and returns it.
+// If the pipeline is empty, ok will be false.
+// @group Lazy
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// First stops the source after the first item, so it is safe on infinite
+// pipelines that eventually yield.
+//
+// Example: integers
+//
+//	v, ok := collection.LazyGenerate(func(i int) int { return i * 3 }).
+//		Filter(func(v int) bool { return v > 10 }).
+//		First()
+//	collection.Dump(v, ok)
+//	// 12 #int
+//	// true #bool
+//
+// Example: empty pipeline
+//
+//	v2, ok2 := collection.NewLazy([]int{}).First()
+//	collection.Dump(v2, ok2)
+//	// 0 #int
+//	// false #bool
+func (l *LazyCollection[T]) First() (value T, ok bool) {
+	for v := range l.seq {
+		return v, true
+	}
+	return value, false
+}
+
+// Reduce runs the pipeline and collapses it into a single accumulated value.
+// @group Lazy
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Accumulation is left to right, matching Collection.Reduce.
+//
+// Example: integers - sum of the first four odd numbers
+//
+//	sum := collection.LazyGenerate(func(i int) int { return i }).
+//		Filter(func(v int) bool { return v%2 == 1 }).
+//		Take(4).
+//		Reduce(0, func(acc, v int) int { return acc + v })
+//	collection.Dump(sum)
+//	// 16 #int
+func (l *LazyCollection[T]) Reduce(initial T, fn func(T, T) T) T {
+	acc := initial
+	for v := range l.seq {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// Count runs the pipeline and returns the number of items it yields.
+// @group Lazy
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	n := collection.NewLazy([]int{1, 2, 3, 4, 5}).
+//		Filter(func(v int) bool { return v > 2 }).
+//		Count()
+//	collection.Dump(n)
+//	// 3 #int
+func (l *LazyCollection[T]) Count() int {
+	n := 0
+	for range l.seq {
+		n++
+	}
+	return n
+}
+
+// Each runs the pipeline and calls fn for every item it yields.
+// @group Lazy
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Unlike Collection.Each, this is terminal: it is the point at which the
+// deferred stages execute.
+//
+// Example: integers
+//
+//	collection.NewLazy([]int{1, 2, 3}).
+//		Map(func(v int) int { return v * v }).
+//		Each(func(v int) {
+//			fmt.Println(v)
+//		})
+//	// 1
+//	// 4
+//	// 9
+func (l *LazyCollection[T]) Each(fn func(T)) {
+	for v := range l.seq {
+		fn(v)
+	}
+}
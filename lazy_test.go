@@ -0,0 +1,250 @@
+package collection
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestLazy_StagesAreDeferred(t *testing.T) {
+	calls := 0
+	lc := NewLazy([]int{1, 2, 3}).
+		Filter(func(v int) bool {
+			calls++
+			return true
+		}).
+		Map(func(v int) int {
+			calls++
+			return v
+		})
+
+	if calls != 0 {
+		t.Fatalf("expected no calls before a terminal op, got %d", calls)
+	}
+
+	lc.Count()
+
+	if calls != 6 {
+		t.Fatalf("expected 6 calls after Count, got %d", calls)
+	}
+}
+
+func TestLazy_FilterTakeShortCircuits(t *testing.T) {
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = i
+	}
+
+	calls := 0
+	out := NewLazy(items).
+		Filter(func(v int) bool {
+			calls++
+			return v%2 == 0
+		}).
+		Take(5).
+		Collect()
+
+	if !reflect.DeepEqual(out.Items(), []int{0, 2, 4, 6, 8}) {
+		t.Fatalf("unexpected items: %v", out.Items())
+	}
+	if calls != 9 {
+		t.Fatalf("expected predicate to run 9 times, got %d", calls)
+	}
+}
+
+func TestLazy_InfiniteGenerateBoundedByTake(t *testing.T) {
+	out := LazyGenerate(func(i int) int { return i * 2 }).Take(4).Collect()
+
+	if !reflect.DeepEqual(out.Items(), []int{0, 2, 4, 6}) {
+		t.Fatalf("unexpected items: %v", out.Items())
+	}
+}
+
+func TestLazy_InfiniteGenerateBoundedByTakeUntilFn(t *testing.T) {
+	out := LazyGenerate(func(i int) int { return i }).
+		TakeUntilFn(func(v int) bool { return v == 3 }).
+		Collect()
+
+	if !reflect.DeepEqual(out.Items(), []int{0, 1, 2}) {
+		t.Fatalf("unexpected items: %v", out.Items())
+	}
+}
+
+func TestLazy_TakeZeroNeverReadsSource(t *testing.T) {
+	reads := 0
+	lc := LazyFromSeq(func(yield func(int) bool) {
+		reads++
+		yield(1)
+	})
+
+	if n := lc.Take(0).Count(); n != 0 {
+		t.Fatalf("expected 0 items, got %d", n)
+	}
+	if reads != 0 {
+		t.Fatalf("expected source not to be read, got %d reads", reads)
+	}
+}
+
+func TestLazy_TakeNegative(t *testing.T) {
+	lc := NewLazy([]int{1, 2, 3, 4, 5})
+
+	if got := lc.Take(-2).Collect().Items(); !reflect.DeepEqual(got, []int{4, 5}) {
+		t.Fatalf("expected [4 5], got %v", got)
+	}
+	if got := lc.Take(-10).Collect().Items(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected whole source, got %v", got)
+	}
+}
+
+func TestLazy_TakeMoreThanAvailable(t *testing.T) {
+	got := NewLazy([]int{1, 2}).Take(5).Collect().Items()
+
+	if !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+}
+
+func TestLazy_Skip(t *testing.T) {
+	lc := NewLazy([]int{1, 2, 3})
+
+	if got := lc.Skip(0).Collect().Items(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("Skip(0) expected all items, got %v", got)
+	}
+	if got := lc.Skip(-1).Collect().Items(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("Skip(-1) expected all items, got %v", got)
+	}
+	if got := lc.Skip(2).Collect().Items(); !reflect.DeepEqual(got, []int{3}) {
+		t.Fatalf("Skip(2) expected [3], got %v", got)
+	}
+	if got := lc.Skip(5).Collect().Items(); len(got) != 0 {
+		t.Fatalf("Skip(5) expected empty, got %v", got)
+	}
+}
+
+func TestLazy_MapDoesNotMutateSource(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	out := NewLazy(items).Map(func(v int) int { return v * 10 }).Collect()
+
+	if !reflect.DeepEqual(items, []int{1, 2, 3}) {
+		t.Fatalf("source mutated: %v", items)
+	}
+	if !reflect.DeepEqual(out.Items(), []int{10, 20, 30}) {
+		t.Fatalf("unexpected items: %v", out.Items())
+	}
+}
+
+func TestLazyMapTo_ChangesType(t *testing.T) {
+	out := LazyMapTo(NewLazy([]int{1, 2}), strconv.Itoa).Collect()
+
+	if !reflect.DeepEqual(out.Items(), []string{"1", "2"}) {
+		t.Fatalf("unexpected items: %v", out.Items())
+	}
+}
+
+func TestLazyChunk_Basic(t *testing.T) {
+	out := LazyChunk(NewLazy([]int{1, 2, 3, 4, 5}), 2).Collect()
+
+	expected := [][]int{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(out.Items(), expected) {
+		t.Fatalf("expected %v, got %v", expected, out.Items())
+	}
+}
+
+func TestLazyChunk_ChunksAreIndependent(t *testing.T) {
+	out := LazyChunk(NewLazy([]int{1, 2, 3, 4}), 2).Collect().Items()
+
+	out[0][0] = 99
+
+	if out[1][0] != 3 {
+		t.Fatalf("chunks should not share storage, got %v", out)
+	}
+}
+
+func TestLazyChunk_NonPositiveSize(t *testing.T) {
+	if n := LazyChunk(NewLazy([]int{1, 2}), 0).Count(); n != 0 {
+		t.Fatalf("expected no chunks for size 0, got %d", n)
+	}
+}
+
+func TestLazy_First(t *testing.T) {
+	v, ok := LazyGenerate(func(i int) int { return i }).
+		Filter(func(v int) bool { return v > 5 }).
+		First()
+
+	if !ok || v != 6 {
+		t.Fatalf("expected (6, true), got (%d, %v)", v, ok)
+	}
+
+	_, ok = NewLazy([]int{}).First()
+	if ok {
+		t.Fatalf("expected ok=false for empty pipeline")
+	}
+}
+
+func TestLazy_ReduceLeftToRight(t *testing.T) {
+	out := NewLazy([]string{"a", "b", "c"}).Reduce("", func(acc, s string) string {
+		return acc + s
+	})
+
+	if out != "abc" {
+		t.Fatalf(`expected "abc", got %q`, out)
+	}
+}
+
+func TestLazy_Each(t *testing.T) {
+	var seen []int
+	NewLazy([]int{1, 2, 3}).Each(func(v int) {
+		seen = append(seen, v)
+	})
+
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Fatalf("unexpected items: %v", seen)
+	}
+}
+
+func TestLazy_ReEvaluatesOnEachTerminal(t *testing.T) {
+	items := []int{1, 2, 3}
+	lc := NewLazy(items).Filter(func(v int) bool { return v > 1 })
+
+	if n := lc.Count(); n != 2 {
+		t.Fatalf("expected 2, got %d", n)
+	}
+
+	items[0] = 5
+
+	if n := lc.Count(); n != 3 {
+		t.Fatalf("expected pipeline to observe borrowed slice changes, got %d", n)
+	}
+}
+
+func TestCollection_Lazy(t *testing.T) {
+	c := New([]int{1, 2, 3, 4})
+
+	out := c.Lazy().Filter(func(v int) bool { return v%2 == 0 }).Collect()
+
+	if !reflect.DeepEqual(out.Items(), []int{2, 4}) {
+		t.Fatalf("unexpected items: %v", out.Items())
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3, 4}) {
+		t.Fatalf("Lazy should not mutate the source collection, got %v", c.Items())
+	}
+}
+
+func TestLazy_ValuesStopsUpstream(t *testing.T) {
+	reads := 0
+	lc := LazyGenerate(func(i int) int {
+		reads++
+		return i
+	})
+
+	got := slices.Collect(lc.Take(3).Values())
+
+	if !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+	if reads != 3 {
+		t.Fatalf("expected 3 reads, got %d", reads)
+	}
+}
@@ -0,0 +1,46 @@
+package collection
+
+import "fmt"
+
+// LineError reports a failure to read or decode a single record of
+// line-oriented input, such as a CSV record or an NDJSON line.
+//
+// Line is the 1-based line on which the record starts. Err is the underlying
+// error: for CSV, the error returned by the parse function or the
+// encoding/csv error (such as csv.ErrFieldCount or csv.ErrQuote) with its
+// position stripped; for NDJSON, the error from encoding/json.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+// Error formats the error with its line number.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: formatting a line error
+//
+//	err := &collection.LineError{Line: 3, Err: errors.New("bad amount")}
+//	fmt.Println(err.Error())
+//	// line 3: bad amount
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error, so errors.Is and errors.As see it.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: matching the cause
+//
+//	cause := errors.New("bad amount")
+//	err := &collection.LineError{Line: 3, Err: cause}
+//	fmt.Println(errors.Is(err, cause))
+//	// true
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,32 @@
+package collection
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLineError_ErrorAndUnwrap(t *testing.T) {
+	cause := errors.New("bad amount")
+	err := error(&LineError{Line: 7, Err: cause})
+
+	if err.Error() != "line 7: bad amount" {
+		t.Fatalf("unexpected message: %s", err.Error())
+	}
+	if !errors.Is(err, cause) {
+		t.Fatalf("expected errors.Is to see the cause")
+	}
+}
+
+func TestLineError_SharedByCSVAndNDJSON(t *testing.T) {
+	_, csvErr := FromCSV(strings.NewReader("a\n\"x"), false, func(r []string) (string, error) { return r[0], nil })
+	_, ndErr := FromNDJSON[int](strings.NewReader("1\n{\n"))
+
+	var le *LineError
+	if !errors.As(csvErr, &le) || le.Line != 2 {
+		t.Fatalf("expected *LineError on line 2 from CSV, got %v", csvErr)
+	}
+	if !errors.As(ndErr, &le) || le.Line != 2 {
+		t.Fatalf("expected *LineError on line 2 from NDJSON, got %v", ndErr)
+	}
+}
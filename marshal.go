@@ -0,0 +1,254 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the collection as a JSON array of its items.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// This makes *Collection (and *NumericCollection, which embeds it) encode
+// transparently as a field of a larger struct. A nil collection or nil
+// items encode as null, matching how encoding/json treats a nil slice.
+//
+// Example: collection as a response field
+//
+//	type Response struct {
+//		Tags *collection.Collection[string] `json:"tags"`
+//	}
+//
+//	out, _ := json.Marshal(Response{Tags: collection.New([]string{"go", "db"})})
+//	fmt.Println(string(out))
+//	// {"tags":["go","db"]}
+func (c *Collection[T]) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(c.items)
+}
+
+// UnmarshalJSON decodes a JSON array into the collection, replacing its items.
+// This method mutates the collection in place.
+// @group Serialization
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// The collection owns the decoded slice. A JSON null leaves the collection
+// unchanged, following the encoding/json convention.
+//
+// Example: decoding a field
+//
+//	type Request struct {
+//		IDs *collection.Collection[int] `json:"ids"`
+//	}
+//
+//	var req Request
+//	_ = json.Unmarshal([]byte(`{"ids":[3,1,2]}`), &req)
+//	collection.Dump(req.IDs.Items())
+//	// #[]int [
+//	//   0 => 3 #int
+//	//   1 => 1 #int
+//	//   2 => 2 #int
+//	// ]
+func (c *Collection[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	c.items = items
+	return nil
+}
+
+// MarshalText encodes the collection as the same JSON array text as
+// MarshalJSON.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// This lets text-based encoders and flag.TextVar handle a *Collection
+// directly. encoding/json prefers MarshalJSON, so JSON output is unchanged.
+//
+// Example: text form
+//
+//	text, _ := collection.New([]string{"a", "b"}).MarshalText()
+//	fmt.Println(string(text))
+//	// ["a","b"]
+func (c *Collection[T]) MarshalText() ([]byte, error) {
+	return c.MarshalJSON()
+}
+
+// UnmarshalText decodes JSON array text into the collection, replacing its
+// items, exactly like UnmarshalJSON.
+// This method mutates the collection in place.
+// @group Serialization
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// Example: command-line flag
+//
+//	fs := flag.NewFlagSet("worker", flag.ContinueOnError)
+//	ids := collection.New([]int{})
+//	fs.TextVar(ids, "ids", collection.New([]int{1}), "ids to process")
+//	_ = fs.Parse([]string{"-ids", "[4,5]"})
+//	collection.Dump(ids.Items())
+//	// #[]int [
+//	//   0 => 4 #int
+//	//   1 => 5 #int
+//	// ]
+func (c *Collection[T]) UnmarshalText(text []byte) error {
+	return c.UnmarshalJSON(text)
+}
+
+// MarshalBinary encodes the collection's items with encoding/gob.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The binary form is intended for caches and other Go-to-Go storage, and can
+// be restored with UnmarshalBinary. encoding/gob uses it automatically, so
+// collections nested in gob-encoded values round-trip as well. Item types
+// must be encodable by gob.
+//
+// Example: cache round trip
+//
+//	src := collection.New([]string{"a", "b"})
+//	blob, _ := src.MarshalBinary()
+//
+//	dst := collection.New([]string{})
+//	_ = dst.UnmarshalBinary(blob)
+//	collection.Dump(dst.Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	// ]
+func (c *Collection[T]) MarshalBinary() ([]byte, error) {
+	var items []T
+	if c != nil {
+		items = c.items
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary into the collection,
+// replacing its items.
+// This method mutates the collection in place.
+// @group Serialization
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// An empty encoded collection decodes to empty, non-nil items.
+//
+// Example: restoring from bytes
+//
+//	blob, _ := collection.New([]int{7, 8}).MarshalBinary()
+//
+//	var restored collection.Collection[int]
+//	_ = restored.UnmarshalBinary(blob)
+//	fmt.Println(restored.Items())
+//	// [7 8]
+func (c *Collection[T]) UnmarshalBinary(data []byte) error {
+	var items []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	if items == nil {
+		items = []T{}
+	}
+	c.items = items
+	return nil
+}
+
+// UnmarshalJSON decodes a JSON array of numbers into the collection,
+// replacing its items.
+// This method mutates the collection in place.
+// @group Serialization
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// It works on a zero NumericCollection, such as a struct field that was never
+// initialized with NewNumeric. A JSON null leaves the collection unchanged.
+//
+// Example: decoding numeric samples
+//
+//	type Metrics struct {
+//		Latency collection.NumericCollection[float64] `json:"latency"`
+//	}
+//
+//	var m Metrics
+//	_ = json.Unmarshal([]byte(`{"latency":[12.5,7.5]}`), &m)
+//	fmt.Println(m.Latency.Sum())
+//	// 20
+func (c *NumericCollection[T]) UnmarshalJSON(data []byte) error {
+	if c.Collection == nil {
+		c.Collection = &Collection[T]{}
+	}
+	return c.Collection.UnmarshalJSON(data)
+}
+
+// UnmarshalText decodes JSON array text into the collection, replacing its
+// items.
+// This method mutates the collection in place.
+// @group Serialization
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// It works on a zero NumericCollection.
+//
+// Example: numeric text
+//
+//	var weights collection.NumericCollection[float64]
+//	_ = weights.UnmarshalText([]byte("[0.5,1.5]"))
+//	fmt.Println(weights.Sum())
+//	// 2
+func (c *NumericCollection[T]) UnmarshalText(text []byte) error {
+	if c.Collection == nil {
+		c.Collection = &Collection[T]{}
+	}
+	return c.Collection.UnmarshalText(text)
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary into the
+// collection, replacing its items.
+// This method mutates the collection in place.
+// @group Serialization
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// It works on a zero NumericCollection.
+//
+// Example: restoring numeric data
+//
+//	blob2, _ := collection.NewNumeric([]int{1, 2, 3}).MarshalBinary()
+//
+//	var totals collection.NumericCollection[int]
+//	_ = totals.UnmarshalBinary(blob2)
+//	fmt.Println(totals.Sum())
+//	// 6
+func (c *NumericCollection[T]) UnmarshalBinary(data []byte) error {
+	if c.Collection == nil {
+		c.Collection = &Collection[T]{}
+	}
+	return c.Collection.UnmarshalBinary(data)
+}
@@ -0,0 +1,226 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMarshalJSON_AsStructField(t *testing.T) {
+	type resp struct {
+		Items *Collection[int]        `json:"items"`
+		Nums  *NumericCollection[int] `json:"nums"`
+		None  *Collection[int]        `json:"none"`
+	}
+
+	out, err := json.Marshal(resp{Items: New([]int{1, 2}), Nums: NewNumeric([]int{3})})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"items":[1,2],"nums":[3],"none":null}`
+	if string(out) != expected {
+		t.Fatalf("expected %s, got %s", expected, out)
+	}
+}
+
+func TestMarshalJSON_NilItemsAndNilReceiver(t *testing.T) {
+	if out, _ := New[int](nil).MarshalJSON(); string(out) != "null" {
+		t.Fatalf("expected null for nil items, got %s", out)
+	}
+
+	var c *Collection[int]
+	if out, _ := c.MarshalJSON(); string(out) != "null" {
+		t.Fatalf("expected null for nil collection, got %s", out)
+	}
+
+	var zero NumericCollection[int]
+	if out, err := json.Marshal(&zero); err != nil || string(out) != "null" {
+		t.Fatalf("expected null for zero NumericCollection, got %s, %v", out, err)
+	}
+}
+
+func TestUnmarshalJSON_RoundTrip(t *testing.T) {
+	type payload struct {
+		Names *Collection[string]        `json:"names"`
+		Temps NumericCollection[float64] `json:"temps"`
+		Empty *Collection[int]           `json:"empty"`
+		Nil   *NumericCollection[int]    `json:"nil"`
+	}
+
+	var p payload
+	err := json.Unmarshal([]byte(`{"names":["a","b"],"temps":[1.5,2.5],"empty":[],"nil":null}`), &p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(p.Names.Items(), []string{"a", "b"}) {
+		t.Fatalf("unexpected names: %v", p.Names.Items())
+	}
+	if p.Temps.Sum() != 4 {
+		t.Fatalf("unexpected temps: %v", p.Temps.Items())
+	}
+	if p.Empty.Items() == nil || len(p.Empty.Items()) != 0 {
+		t.Fatalf("expected empty non-nil items, got %#v", p.Empty.Items())
+	}
+	if p.Nil != nil {
+		t.Fatalf("expected nil pointer for null")
+	}
+}
+
+func TestUnmarshalJSON_NullIsNoOp(t *testing.T) {
+	c := New([]int{1})
+
+	if err := c.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1}) {
+		t.Fatalf("null should leave items unchanged, got %v", c.Items())
+	}
+}
+
+func TestUnmarshalJSON_ErrorKeepsItems(t *testing.T) {
+	c := New([]int{1})
+
+	if err := json.Unmarshal([]byte(`["x"]`), c); err == nil {
+		t.Fatalf("expected a type error")
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1}) {
+		t.Fatalf("failed decode should leave items unchanged, got %v", c.Items())
+	}
+}
+
+func TestMarshalBinary_RoundTrip(t *testing.T) {
+	type rec struct {
+		ID   int
+		Tags []string
+	}
+	src := New([]rec{{1, []string{"a"}}, {2, nil}})
+
+	blob, err := src.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var dst Collection[rec]
+	if err := dst.UnmarshalBinary(blob); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(dst.Items(), src.Items()) {
+		t.Fatalf("round trip mismatch: %v", dst.Items())
+	}
+}
+
+func TestMarshalBinary_EmptyDecodesNonNil(t *testing.T) {
+	blob, _ := New([]int{}).MarshalBinary()
+
+	var zero NumericCollection[int]
+	if err := zero.UnmarshalBinary(blob); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if zero.Items() == nil || len(zero.Items()) != 0 {
+		t.Fatalf("expected empty non-nil items, got %#v", zero.Items())
+	}
+}
+
+func TestMarshalBinary_UsedByGob(t *testing.T) {
+	type cached struct {
+		Key  string
+		Vals *Collection[int]
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(cached{Key: "k", Vals: New([]int{4, 5})}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var out cached
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Key != "k" || !reflect.DeepEqual(out.Vals.Items(), []int{4, 5}) {
+		t.Fatalf("unexpected gob round trip: %+v %v", out, out.Vals.Items())
+	}
+}
+
+func TestUnmarshalBinary_InvalidData(t *testing.T) {
+	var c Collection[int]
+
+	if err := c.UnmarshalBinary([]byte("not gob")); err == nil {
+		t.Fatalf("expected error for invalid data")
+	}
+}
+
+func TestToJSON_SameAsMarshalJSON(t *testing.T) {
+	c := New([]string{"<a>", "b"})
+
+	s, _ := c.ToJSON()
+	b, _ := json.Marshal(c)
+
+	if s != string(b) {
+		t.Fatalf("ToJSON %s differs from json.Marshal %s", s, b)
+	}
+}
+
+func TestToJSON_KeepsUnwrappedErrors(t *testing.T) {
+	_, err := New([]failingMarshaler{{fail: true}}).ToJSON()
+	if err != errMarshalFailed {
+		t.Fatalf("expected the MarshalJSON error to surface directly, got %v", err)
+	}
+
+	_, err = New([]failingMarshaler{{fail: true}}).ToPrettyJSON()
+	if !errors.Is(err, errMarshalFailed) || err != errMarshalFailed {
+		t.Fatalf("expected the MarshalJSON error to surface directly, got %v", err)
+	}
+}
+
+func TestMarshalText_MatchesJSON(t *testing.T) {
+	c := New([]string{"a", "b"})
+
+	text, err := c.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	js, _ := c.MarshalJSON()
+	if string(text) != string(js) {
+		t.Fatalf("expected %s, got %s", js, text)
+	}
+
+	var nilC *Collection[int]
+	if out, _ := nilC.MarshalText(); string(out) != "null" {
+		t.Fatalf("expected null for nil collection, got %s", out)
+	}
+}
+
+func TestUnmarshalText_RoundTrip(t *testing.T) {
+	var c Collection[int]
+	if err := c.UnmarshalText([]byte("[1,2]")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2}) {
+		t.Fatalf("unexpected items: %v", c.Items())
+	}
+
+	var n NumericCollection[int]
+	if err := n.UnmarshalText([]byte("[3,4]")); err != nil || n.Sum() != 7 {
+		t.Fatalf("unexpected NumericCollection result: %v, %v", n.Items(), err)
+	}
+
+	if err := c.UnmarshalText([]byte("oops")); err == nil {
+		t.Fatalf("expected error for invalid text")
+	}
+}
+
+func TestMarshalText_JSONStillUsesMarshalJSON(t *testing.T) {
+	type wrapper struct {
+		C *Collection[int] `json:"c"`
+	}
+
+	out, _ := json.Marshal(wrapper{C: New([]int{1})})
+	if string(out) != `{"c":[1]}` {
+		t.Fatalf("expected JSON array, got %s", out)
+	}
+}
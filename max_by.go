@@ -73,3 +73,47 @@ func MaxBy[T any, K Number | ~string](c *Collection[T], keyFn func(T) K) (T, boo
 
 	return maxItem, true
 }
+
+// MaxWith returns the largest item according to cmp.
+// The second return value is false if the collection is empty.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// cmp has the same contract as SortWith and accepts Comparator.Compare.
+// When multiple items compare as maximal, the first such item is returned.
+//
+// Example: structs - multi-key comparator
+//
+//	type User struct {
+//		Name string
+//		Age  int
+//	}
+//
+//	users := collection.New([]User{
+//		{Name: "Alice", Age: 30},
+//		{Name: "Bob", Age: 25},
+//		{Name: "Carol", Age: 40},
+//	})
+//
+//	u, ok := users.MaxWith(collection.By(func(u User) int { return u.Age }).Compare)
+//	collection.Dump(u.Name, ok)
+//	// "Carol" #string
+//	// true #bool
+func (c *Collection[T]) MaxWith(cmp func(a, b T) int) (T, bool) {
+	var zero T
+
+	if len(c.items) == 0 {
+		return zero, false
+	}
+
+	maxItem := c.items[0]
+	for _, item := range c.items[1:] {
+		if cmp(item, maxItem) > 0 {
+			maxItem = item
+		}
+	}
+
+	return maxItem, true
+}
@@ -74,3 +74,26 @@ func TestMaxBy_TiesReturnFirst(t *testing.T) {
 		t.Fatalf("expected first maximal value 3, got %d", maxVal)
 	}
 }
+
+func TestMaxWith_MultiKey(t *testing.T) {
+	type row struct {
+		group string
+		n     int
+	}
+
+	c := New([]row{{"a", 9}, {"b", 1}, {"b", 3}, {"b", 3}})
+
+	v, ok := c.MaxWith(By(func(r row) string { return r.group }).Then(By(func(r row) int { return r.n })).Compare)
+
+	if !ok || v != (row{"b", 3}) {
+		t.Fatalf("expected {b 3}, got %v", v)
+	}
+}
+
+func TestMaxWith_Empty(t *testing.T) {
+	_, ok := New([]int{}).MaxWith(By(func(v int) int { return v }).Compare)
+
+	if ok {
+		t.Fatalf("expected ok=false for empty collection")
+	}
+}
@@ -1,6 +1,9 @@
 package collection
 
-import "sort"
+import (
+	"cmp"
+	"slices"
+)
 
 // Median returns the statistical median of the numeric collection as float64.
 // Returns (0, false) if the collection is empty.
@@ -53,21 +56,21 @@ func (c *NumericCollection[T]) Median() (float64, bool) {
 		return 0, false
 	}
 
-	// Make a copy so sorting does not mutate the original collection
+	// Make a copy so selection does not mutate the original collection
 	cp := make([]T, n)
 	copy(cp, c.items)
 
-	sort.Slice(cp, func(i, j int) bool { return cp[i] < cp[j] })
-
 	mid := n / 2
+	quickselect(cp, mid, cmp.Compare[T])
 
 	// Odd
 	if n%2 == 1 {
 		return float64(cp[mid]), true
 	}
 
-	// Even
-	a := float64(cp[mid-1])
+	// Even: everything left of mid orders before it, so the lower middle
+	// value is the largest of that partition.
+	a := float64(slices.Max(cp[:mid]))
 	b := float64(cp[mid])
 	return (a + b) / 2, true
 }
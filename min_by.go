@@ -73,3 +73,47 @@ func MinBy[T any, K Number | ~string](c *Collection[T], keyFn func(T) K) (T, boo
 
 	return minItem, true
 }
+
+// MinWith returns the smallest item according to cmp.
+// The second return value is false if the collection is empty.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// cmp has the same contract as SortWith and accepts Comparator.Compare.
+// When multiple items compare as minimal, the first such item is returned.
+//
+// Example: structs - multi-key comparator
+//
+//	type User struct {
+//		Name string
+//		Age  int
+//	}
+//
+//	users := collection.New([]User{
+//		{Name: "Alice", Age: 30},
+//		{Name: "Bob", Age: 25},
+//		{Name: "Carol", Age: 40},
+//	})
+//
+//	u, ok := users.MinWith(collection.By(func(u User) int { return u.Age }).Compare)
+//	collection.Dump(u.Name, ok)
+//	// "Bob" #string
+//	// true #bool
+func (c *Collection[T]) MinWith(cmp func(a, b T) int) (T, bool) {
+	var zero T
+
+	if len(c.items) == 0 {
+		return zero, false
+	}
+
+	minItem := c.items[0]
+	for _, item := range c.items[1:] {
+		if cmp(item, minItem) < 0 {
+			minItem = item
+		}
+	}
+
+	return minItem, true
+}
@@ -74,3 +74,21 @@ func TestMinBy_TiesReturnFirst(t *testing.T) {
 		t.Fatalf("expected first minimal value 1, got %d", minVal)
 	}
 }
+
+func TestMinWith_FirstOnTie(t *testing.T) {
+	c := New([]string{"bb", "a", "c"})
+
+	v, ok := c.MinWith(By(func(s string) int { return len(s) }).Compare)
+
+	if !ok || v != "a" {
+		t.Fatalf(`expected ("a", true), got (%q, %v)`, v, ok)
+	}
+}
+
+func TestMinWith_Empty(t *testing.T) {
+	_, ok := New([]int{}).MinWith(By(func(v int) int { return v }).Compare)
+
+	if ok {
+		t.Fatalf("expected ok=false for empty collection")
+	}
+}
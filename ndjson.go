@@ -0,0 +1,150 @@
+package collection
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+)
+
+// WriteNDJSON writes the collection to w as newline-delimited JSON (JSON
+// Lines), one compact JSON value per item.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Items are encoded one at a time through a buffered writer, so the output
+// is never built in memory as a whole. If an item fails to encode, writing
+// stops and an *ItemError with its index is returned; as with ToJSON, a
+// user-defined MarshalJSON error is unwrapped so it surfaces directly.
+// Items before the failing one have already been written.
+//
+// Example: exporting records
+//
+//	type Event struct {
+//		ID   int    `json:"id"`
+//		Kind string `json:"kind"`
+//	}
+//
+//	events := collection.New([]Event{{ID: 1, Kind: "login"}, {ID: 2, Kind: "logout"}})
+//	_ = events.WriteNDJSON(os.Stdout)
+//	// {"id":1,"kind":"login"}
+//	// {"id":2,"kind":"logout"}
+func (c *Collection[T]) WriteNDJSON(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	for i, v := range c.items {
+		if err := enc.Encode(v); err != nil {
+			var me *json.MarshalerError
+			if errors.As(err, &me) {
+				err = me.Unwrap()
+			}
+			_ = bw.Flush()
+			return &ItemError[T]{Index: i, Item: v, Err: err}
+		}
+	}
+	return bw.Flush()
+}
+
+// FromNDJSON reads newline-delimited JSON from r into a new collection.
+// @group Serialization
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// Blank lines are skipped. FromNDJSON stops at the first line that fails to
+// decode and returns the items decoded so far together with an *LineError
+// carrying the line number. Use ReadNDJSON to stream lines or skip bad ones.
+//
+// Example: loading records
+//
+//	type Event struct {
+//		ID   int    `json:"id"`
+//		Kind string `json:"kind"`
+//	}
+//
+//	input := "{\"id\":1,\"kind\":\"login\"}\n{\"id\":2,\"kind\":\"logout\"}\n"
+//	events, err := collection.FromNDJSON[Event](strings.NewReader(input))
+//	collection.Dump(events.Items())
+//	fmt.Println(err)
+//	// #[]main.Event [
+//	//   0 => #main.Event {
+//	//     +ID   => 1 #int
+//	//     +Kind => "login" #string
+//	//   }
+//	//   1 => #main.Event {
+//	//     +ID   => 2 #int
+//	//     +Kind => "logout" #string
+//	//   }
+//	// ]
+//	// <nil>
+func FromNDJSON[T any](r io.Reader) (*Collection[T], error) {
+	items := []T{}
+	for v, err := range ReadNDJSON[T](r) {
+		if err != nil {
+			return New(items), err
+		}
+		items = append(items, v)
+	}
+	return New(items), nil
+}
+
+// ReadNDJSON streams newline-delimited JSON from r, yielding each decoded
+// value or the error for that line.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Lines are read one at a time as the sequence is consumed, with no limit on
+// line length. Blank lines are skipped. A line that fails to decode yields a
+// zero value with an *LineError, and iteration continues with the next
+// line. An I/O error from r is yielded once and ends the sequence.
+//
+// Example: skipping bad lines
+//
+//	input := "1\n2\noops\n4\n"
+//	total := 0
+//	for v, err := range collection.ReadNDJSON[int](strings.NewReader(input)) {
+//		if err != nil {
+//			fmt.Println("skip:", err)
+//			continue
+//		}
+//		total += v
+//	}
+//	fmt.Println(total)
+//	// skip: line 3: invalid character 'o' looking for beginning of value
+//	// 7
+func ReadNDJSON[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		br := bufio.NewReader(r)
+		var zero T
+
+		for line := 1; ; line++ {
+			b, err := br.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				yield(zero, err)
+				return
+			}
+
+			if data := bytes.TrimSpace(b); len(data) > 0 {
+				var v T
+				if uerr := json.Unmarshal(data, &v); uerr != nil {
+					if !yield(zero, &LineError{Line: line, Err: uerr}) {
+						return
+					}
+				} else if !yield(v, nil) {
+					return
+				}
+			}
+
+			if err == io.EOF {
+				return
+			}
+		}
+	}
+}
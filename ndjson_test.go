@@ -0,0 +1,153 @@
+package collection
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type failingMarshaler struct{ fail bool }
+
+var errMarshalFailed = errors.New("marshal failed")
+
+func (f failingMarshaler) MarshalJSON() ([]byte, error) {
+	if f.fail {
+		return nil, errMarshalFailed
+	}
+	return []byte(`"ok"`), nil
+}
+
+type errReader struct {
+	data string
+	err  error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestWriteNDJSON_OneValuePerLine(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := New([]map[string]int{{"a": 1}, {"b": 2}}).WriteNDJSON(&buf)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "{\"a\":1}\n{\"b\":2}\n" {
+		t.Fatalf("unexpected output: %q", buf.String())
+	}
+}
+
+func TestWriteNDJSON_ItemErrorUnwrapsMarshaler(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := New([]failingMarshaler{{}, {fail: true}, {}}).WriteNDJSON(&buf)
+
+	var ie *ItemError[failingMarshaler]
+	if !errors.As(err, &ie) || ie.Index != 1 {
+		t.Fatalf("expected ItemError at index 1, got %v", err)
+	}
+	if ie.Err != errMarshalFailed {
+		t.Fatalf("expected the MarshalJSON error to surface directly, got %v", ie.Err)
+	}
+	if buf.String() != "\"ok\"\n" {
+		t.Fatalf("expected items before the failure to be written, got %q", buf.String())
+	}
+}
+
+func TestFromNDJSON_RoundTrip(t *testing.T) {
+	type rec struct {
+		ID   int
+		Tags []string
+	}
+	src := New([]rec{{1, []string{"a"}}, {2, nil}})
+
+	var buf bytes.Buffer
+	_ = src.WriteNDJSON(&buf)
+
+	out, err := FromNDJSON[rec](&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), src.Items()) {
+		t.Fatalf("round trip mismatch: %v", out.Items())
+	}
+}
+
+func TestFromNDJSON_StopsAtBadLine(t *testing.T) {
+	out, err := FromNDJSON[int](strings.NewReader("1\n\n2\n{bad\n3\n"))
+
+	var ne *LineError
+	if !errors.As(err, &ne) || ne.Line != 4 {
+		t.Fatalf("expected LineError on line 4, got %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), []int{1, 2}) {
+		t.Fatalf("expected partial [1 2], got %v", out.Items())
+	}
+}
+
+func TestReadNDJSON_NoTrailingNewlineAndCRLF(t *testing.T) {
+	var got []string
+	for v, err := range ReadNDJSON[string](strings.NewReader("\"a\"\r\n\"b\"")) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Fatalf("expected [a b], got %v", got)
+	}
+}
+
+func TestReadNDJSON_LongLines(t *testing.T) {
+	long := strings.Repeat("x", 200_000)
+
+	out, err := FromNDJSON[string](strings.NewReader(`"` + long + `"` + "\n"))
+
+	if err != nil || len(out.Items()) != 1 || out.Items()[0] != long {
+		t.Fatalf("failed to decode a long line: %v", err)
+	}
+}
+
+func TestReadNDJSON_ReaderError(t *testing.T) {
+	boom := errors.New("boom")
+	r := &errReader{data: "1\n", err: boom}
+
+	var vals []int
+	var errs []error
+	for v, err := range ReadNDJSON[int](r) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		vals = append(vals, v)
+	}
+
+	if !reflect.DeepEqual(vals, []int{1}) || len(errs) != 1 || errs[0] != boom {
+		t.Fatalf("expected [1] then boom, got %v %v", vals, errs)
+	}
+}
+
+func TestReadNDJSON_StopsWhenConsumerBreaks(t *testing.T) {
+	r := &errReader{data: "1\n2\n", err: io.EOF}
+
+	n := 0
+	for range ReadNDJSON[int](r) {
+		n++
+		break
+	}
+
+	if n != 1 {
+		t.Fatalf("expected one value, got %d", n)
+	}
+}
@@ -0,0 +1,393 @@
+package collection
+
+import (
+	"iter"
+	"slices"
+)
+
+// OrderedMap is a map that remembers the order in which keys were first set.
+//
+// Iteration, Keys, Values, Pairs and JSON output all follow insertion order,
+// which makes OrderedMap a drop-in for Laravel-style associative arrays and
+// API payloads that need stable key ordering. Updating an existing key keeps
+// its position; deleting and re-setting a key moves it to the end.
+//
+// The zero value is an empty map ready to use. OrderedMap is not safe for
+// concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+// NewOrderedMap creates an empty OrderedMap.
+// @group Maps
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Example: building a map in order
+//
+//	m := collection.NewOrderedMap[string, int]().
+//		Set("b", 2).
+//		Set("a", 1).
+//		Set("c", 3)
+//
+//	collection.Dump(m.Keys().Items())
+//	// #[]string [
+//	//   0 => "b" #string
+//	//   1 => "a" #string
+//	//   2 => "c" #string
+//	// ]
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{values: map[K]V{}}
+}
+
+// OrderedMapFromPairs creates an OrderedMap from pairs, in collection order.
+// @group Maps
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// If multiple pairs contain the same key, the last value wins and the key
+// keeps the position of its first occurrence.
+//
+// Example: from a sorted FromMap result
+//
+//	c := collection.FromMap(map[string]int{"b": 2, "a": 1})
+//	c.Sort(func(x, y collection.Pair[string, int]) bool { return x.Key < y.Key })
+//
+//	m := collection.OrderedMapFromPairs(c)
+//	out, _ := json.Marshal(m)
+//	fmt.Println(string(out))
+//	// {"a":1,"b":2}
+func OrderedMapFromPairs[K comparable, V any](c *Collection[Pair[K, V]]) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{
+		keys:   make([]K, 0, len(c.items)),
+		values: make(map[K]V, len(c.items)),
+	}
+	for _, p := range c.items {
+		m.Set(p.Key, p.Value)
+	}
+	return m
+}
+
+// Len returns the number of keys in the map.
+// @group Maps
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: counting keys
+//
+//	m := collection.NewOrderedMap[string, int]().Set("a", 1).Set("b", 2)
+//	fmt.Println(m.Len())
+//	// 2
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.keys)
+}
+
+// Get returns the value stored for key and whether it was present.
+// @group Maps
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: present and missing keys
+//
+//	m := collection.NewOrderedMap[string, int]().Set("a", 1)
+//
+//	v, ok := m.Get("a")
+//	fmt.Println(v, ok)
+//	// 1 true
+//
+//	v2, ok2 := m.Get("z")
+//	fmt.Println(v2, ok2)
+//	// 0 false
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// Has reports whether key is present in the map.
+// @group Maps
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: checking a key
+//
+//	m := collection.NewOrderedMap[string, int]().Set("a", 1)
+//	fmt.Println(m.Has("a"), m.Has("z"))
+//	// true false
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.values[key]
+	return ok
+}
+
+// Set stores value for key and returns the same map.
+// This method mutates the map in place.
+// @group Maps
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// New keys are appended to the end. Setting an existing key replaces its
+// value without changing its position.
+//
+// Example: updating keeps position
+//
+//	m := collection.NewOrderedMap[string, int]().
+//		Set("a", 1).
+//		Set("b", 2).
+//		Set("a", 10)
+//
+//	out, _ := json.Marshal(m)
+//	fmt.Println(string(out))
+//	// {"a":10,"b":2}
+func (m *OrderedMap[K, V]) Set(key K, value V) *OrderedMap[K, V] {
+	if m.values == nil {
+		m.values = map[K]V{}
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+	return m
+}
+
+// Delete removes key from the map, if present, and returns the same map.
+// This method mutates the map in place.
+// @group Maps
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// The remaining keys keep their relative order. Delete is O(n) in the
+// number of keys.
+//
+// Example: removing a key
+//
+//	m := collection.NewOrderedMap[string, int]().
+//		Set("a", 1).
+//		Set("b", 2).
+//		Set("c", 3).
+//		Delete("b")
+//
+//	collection.Dump(m.Keys().Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "c" #string
+//	// ]
+func (m *OrderedMap[K, V]) Delete(key K) *OrderedMap[K, V] {
+	if _, ok := m.values[key]; !ok {
+		return m
+	}
+	delete(m.values, key)
+	if i := slices.Index(m.keys, key); i >= 0 {
+		m.keys = slices.Delete(m.keys, i, i+1)
+	}
+	return m
+}
+
+// Keys returns the keys as a new collection, in order.
+// @group Maps
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// The returned collection owns a copy of the keys; changing it does not
+// affect the map.
+//
+// Example: keys in insertion order
+//
+//	m := collection.NewOrderedMap[string, int]().Set("z", 26).Set("a", 1)
+//	collection.Dump(m.Keys().Items())
+//	// #[]string [
+//	//   0 => "z" #string
+//	//   1 => "a" #string
+//	// ]
+func (m *OrderedMap[K, V]) Keys() *Collection[K] {
+	out := make([]K, len(m.keys))
+	copy(out, m.keys)
+	return New(out)
+}
+
+// Values returns the values as a new collection, in key order.
+// @group Maps
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: values in insertion order
+//
+//	m := collection.NewOrderedMap[string, int]().Set("z", 26).Set("a", 1)
+//	collection.Dump(m.Values().Items())
+//	// #[]int [
+//	//   0 => 26 #int
+//	//   1 => 1 #int
+//	// ]
+func (m *OrderedMap[K, V]) Values() *Collection[V] {
+	out := make([]V, len(m.keys))
+	for i, k := range m.keys {
+		out[i] = m.values[k]
+	}
+	return New(out)
+}
+
+// Pairs returns the entries as a new collection of key/value pairs, in order.
+// @group Maps
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: entries as pairs
+//
+//	m := collection.NewOrderedMap[string, int]().Set("b", 2).Set("a", 1)
+//	collection.Dump(m.Pairs().Items())
+//	// #[]collection.Pair[string,int] [
+//	//   0 => #collection.Pair[string,int] {
+//	//     +Key   => "b" #string
+//	//     +Value => 2 #int
+//	//   }
+//	//   1 => #collection.Pair[string,int] {
+//	//     +Key   => "a" #string
+//	//     +Value => 1 #int
+//	//   }
+//	// ]
+func (m *OrderedMap[K, V]) Pairs() *Collection[Pair[K, V]] {
+	out := make([]Pair[K, V], len(m.keys))
+	for i, k := range m.keys {
+		out[i] = Pair[K, V]{Key: k, Value: m.values[k]}
+	}
+	return New(out)
+}
+
+// Entries returns an iterator over key/value entries, in order.
+// @group Maps
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The map must not be modified while iterating.
+//
+// Example: ranging over entries
+//
+//	m := collection.NewOrderedMap[string, int]().Set("b", 2).Set("a", 1)
+//	for k, v := range m.Entries() {
+//		fmt.Println(k, v)
+//	}
+//	// b 2
+//	// a 1
+func (m *OrderedMap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range m.keys {
+			if !yield(k, m.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Filter keeps only the entries for which fn returns true.
+// This method mutates the map in place and returns the same instance.
+// @group Maps
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Kept entries retain their relative order.
+//
+// Example: filter by key and value
+//
+//	m := collection.NewOrderedMap[string, int]().
+//		Set("a", 1).
+//		Set("skip", 2).
+//		Set("c", 3)
+//
+//	m.Filter(func(k string, v int) bool {
+//		return k != "skip" && v > 0
+//	})
+//
+//	collection.Dump(m.Keys().Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "c" #string
+//	// ]
+func (m *OrderedMap[K, V]) Filter(fn func(K, V) bool) *OrderedMap[K, V] {
+	keys := m.keys
+	j := 0
+	for _, k := range keys {
+		if fn(k, m.values[k]) {
+			keys[j] = k
+			j++
+			continue
+		}
+		delete(m.values, k)
+	}
+
+	var zero K
+	for i := j; i < len(keys); i++ {
+		keys[i] = zero
+	}
+	m.keys = keys[:j]
+	return m
+}
+
+// SortByKey reorders the entries so that keys follow less.
+// This method mutates the map in place and returns the same instance.
+// @group Maps
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// The sort is stable.
+//
+// Example: alphabetical keys
+//
+//	m := collection.NewOrderedMap[string, int]().
+//		Set("c", 3).
+//		Set("a", 1).
+//		Set("b", 2)
+//
+//	m.SortByKey(func(a, b string) bool { return a < b })
+//	out, _ := json.Marshal(m)
+//	fmt.Println(string(out))
+//	// {"a":1,"b":2,"c":3}
+func (m *OrderedMap[K, V]) SortByKey(less func(a, b K) bool) *OrderedMap[K, V] {
+	slices.SortStableFunc(m.keys, lessToCmp(less))
+	return m
+}
+
+// MapValues returns a new OrderedMap with fn applied to every value,
+// keeping the original key order.
+// @group Maps
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: formatting values
+//
+//	prices := collection.NewOrderedMap[string, float64]().
+//		Set("tea", 2.5).
+//		Set("coffee", 3)
+//
+//	labels := collection.MapValues(prices, func(k string, v float64) string {
+//		return fmt.Sprintf("$%.2f", v)
+//	})
+//
+//	out, _ := json.Marshal(labels)
+//	fmt.Println(string(out))
+//	// {"tea":"$2.50","coffee":"$3.00"}
+func MapValues[K comparable, V any, R any](m *OrderedMap[K, V], fn func(K, V) R) *OrderedMap[K, R] {
+	out := &OrderedMap[K, R]{
+		keys:   make([]K, len(m.keys)),
+		values: make(map[K]R, len(m.keys)),
+	}
+	copy(out.keys, m.keys)
+	for _, k := range m.keys {
+		out.values[k] = fn(k, m.values[k])
+	}
+	return out
+}
@@ -0,0 +1,177 @@
+package collection
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MarshalJSON encodes the map as a JSON object with keys in map order.
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Keys follow encoding/json map-key rules: string kinds are used directly,
+// encoding.TextMarshaler keys are marshalled to text, and integer kinds are
+// formatted in base 10. Any other key type is an error.
+//
+// Example: stable key order
+//
+//	m := collection.NewOrderedMap[string, any]().
+//		Set("id", 7).
+//		Set("name", "router-1").
+//		Set("enabled", true)
+//
+//	out, _ := json.Marshal(m)
+//	fmt.Println(string(out))
+//	// {"id":7,"name":"router-1","enabled":true}
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, k := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		ks, err := encodeMapKey(k)
+		if err != nil {
+			return nil, err
+		}
+		kb, err := json.Marshal(ks)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(kb)
+		buf.WriteByte(':')
+
+		vb, err := json.Marshal(m.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(vb)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map, preserving the order in
+// which keys appear in the input.
+// @group Serialization
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// Any existing entries are replaced. A JSON null leaves the map unchanged.
+// If a key appears more than once, the last value wins and the key keeps
+// its first position. Keys are decoded with the same rules as MarshalJSON.
+//
+// Example: decoding keeps order
+//
+//	var m collection.OrderedMap[string, int]
+//	_ = json.Unmarshal([]byte(`{"z":1,"a":2,"m":3}`), &m)
+//	collection.Dump(m.Keys().Items())
+//	// #[]string [
+//	//   0 => "z" #string
+//	//   1 => "a" #string
+//	//   2 => "m" #string
+//	// ]
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("collection: cannot unmarshal %v into OrderedMap", tok)
+	}
+
+	out := OrderedMap[K, V]{values: map[K]V{}}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, err := decodeMapKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+
+		var v V
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		out.Set(key, v)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	*m = out
+	return nil
+}
+
+// encodeMapKey converts k into a JSON object key using encoding/json's
+// map-key rules.
+func encodeMapKey(k any) (string, error) {
+	rv := reflect.ValueOf(k)
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+	if tm, ok := k.(encoding.TextMarshaler); ok {
+		b, err := tm.MarshalText()
+		return string(b), err
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("collection: unsupported OrderedMap key type %T", k)
+}
+
+// decodeMapKey is the inverse of encodeMapKey.
+func decodeMapKey[K comparable](s string) (K, error) {
+	var k K
+	rv := reflect.ValueOf(&k).Elem()
+
+	if rv.Kind() == reflect.String {
+		rv.SetString(s)
+		return k, nil
+	}
+	if tu, ok := any(&k).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(s))
+		return k, err
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return k, fmt.Errorf("collection: invalid OrderedMap key %q: %w", s, err)
+		}
+		rv.SetInt(n)
+		return k, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return k, fmt.Errorf("collection: invalid OrderedMap key %q: %w", s, err)
+		}
+		rv.SetUint(n)
+		return k, nil
+	}
+	return k, fmt.Errorf("collection: unsupported OrderedMap key type %T", k)
+}
@@ -0,0 +1,208 @@
+package collection
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOrderedMap_SetKeepsInsertionOrder(t *testing.T) {
+	m := NewOrderedMap[string, int]().Set("b", 2).Set("a", 1).Set("b", 20)
+
+	if !reflect.DeepEqual(m.Keys().Items(), []string{"b", "a"}) {
+		t.Fatalf("unexpected key order: %v", m.Keys().Items())
+	}
+	if v, _ := m.Get("b"); v != 20 {
+		t.Fatalf("expected updated value 20, got %d", v)
+	}
+	if m.Len() != 2 {
+		t.Fatalf("expected len 2, got %d", m.Len())
+	}
+}
+
+func TestOrderedMap_ZeroValueUsable(t *testing.T) {
+	var m OrderedMap[string, int]
+
+	if m.Has("a") || m.Len() != 0 {
+		t.Fatalf("expected empty zero value")
+	}
+
+	m.Set("a", 1)
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected a=1, got %d (ok=%v)", v, ok)
+	}
+}
+
+func TestOrderedMap_DeleteAndReinsertMovesToEnd(t *testing.T) {
+	m := NewOrderedMap[string, int]().Set("a", 1).Set("b", 2).Set("c", 3)
+
+	m.Delete("a").Delete("missing").Set("a", 10)
+
+	if !reflect.DeepEqual(m.Keys().Items(), []string{"b", "c", "a"}) {
+		t.Fatalf("unexpected key order: %v", m.Keys().Items())
+	}
+	if !reflect.DeepEqual(m.Values().Items(), []int{2, 3, 10}) {
+		t.Fatalf("unexpected values: %v", m.Values().Items())
+	}
+}
+
+func TestOrderedMap_KeysReturnsCopy(t *testing.T) {
+	m := NewOrderedMap[string, int]().Set("a", 1)
+
+	m.Keys().Items()[0] = "mutated"
+
+	if !m.Has("a") || m.Keys().Items()[0] != "a" {
+		t.Fatalf("Keys should not expose internal storage")
+	}
+}
+
+func TestOrderedMap_FilterIsKeyAware(t *testing.T) {
+	m := NewOrderedMap[string, int]().Set("a", 1).Set("b", 2).Set("c", 3)
+
+	m.Filter(func(k string, v int) bool { return k != "b" })
+
+	if !reflect.DeepEqual(m.Keys().Items(), []string{"a", "c"}) {
+		t.Fatalf("unexpected keys: %v", m.Keys().Items())
+	}
+	if m.Has("b") {
+		t.Fatalf("filtered key should be removed from lookups")
+	}
+}
+
+func TestOrderedMap_SortByKeyAndPairs(t *testing.T) {
+	m := NewOrderedMap[int, string]().Set(3, "c").Set(1, "a").Set(2, "b")
+
+	m.SortByKey(func(a, b int) bool { return a < b })
+
+	expected := []Pair[int, string]{{1, "a"}, {2, "b"}, {3, "c"}}
+	if !reflect.DeepEqual(m.Pairs().Items(), expected) {
+		t.Fatalf("expected %v, got %v", expected, m.Pairs().Items())
+	}
+}
+
+func TestOrderedMap_EntriesStopsEarly(t *testing.T) {
+	m := NewOrderedMap[string, int]().Set("a", 1).Set("b", 2).Set("c", 3)
+
+	var seen []string
+	for k := range m.Entries() {
+		seen = append(seen, k)
+		if k == "b" {
+			break
+		}
+	}
+
+	if !reflect.DeepEqual(seen, []string{"a", "b"}) {
+		t.Fatalf("expected [a b], got %v", seen)
+	}
+}
+
+func TestMapValues_PreservesOrderAndSource(t *testing.T) {
+	m := NewOrderedMap[string, int]().Set("x", 1).Set("y", 2)
+
+	out := MapValues(m, func(k string, v int) string { return k + strings.Repeat("!", v) })
+
+	if !reflect.DeepEqual(out.Values().Items(), []string{"x!", "y!!"}) {
+		t.Fatalf("unexpected mapped values: %v", out.Values().Items())
+	}
+	if v, _ := m.Get("y"); v != 2 {
+		t.Fatalf("source map was modified")
+	}
+}
+
+func TestOrderedMapFromPairs_DuplicateKeys(t *testing.T) {
+	c := New([]Pair[string, int]{{"a", 1}, {"b", 2}, {"a", 3}})
+
+	m := OrderedMapFromPairs(c)
+
+	if !reflect.DeepEqual(m.Pairs().Items(), []Pair[string, int]{{"a", 3}, {"b", 2}}) {
+		t.Fatalf("unexpected pairs: %v", m.Pairs().Items())
+	}
+}
+
+func TestOrderedMap_JSONRoundTripKeepsOrder(t *testing.T) {
+	in := `{"zeta":1,"alpha":2,"mid":3}`
+
+	var m OrderedMap[string, int]
+	if err := json.Unmarshal([]byte(in), &m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, err := json.Marshal(&m)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(out) != in {
+		t.Fatalf("expected %s, got %s", in, out)
+	}
+}
+
+func TestOrderedMap_JSONNestedAndEmpty(t *testing.T) {
+	type payload struct {
+		Data *OrderedMap[string, []int] `json:"data"`
+	}
+
+	p := payload{Data: NewOrderedMap[string, []int]().Set("b", []int{1}).Set("a", nil)}
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(out) != `{"data":{"b":[1],"a":null}}` {
+		t.Fatalf("unexpected JSON: %s", out)
+	}
+
+	empty, _ := json.Marshal(NewOrderedMap[string, int]())
+	if string(empty) != `{}` {
+		t.Fatalf("expected {}, got %s", empty)
+	}
+}
+
+func TestOrderedMap_JSONIntAndTextKeys(t *testing.T) {
+	ints := NewOrderedMap[int, string]().Set(10, "ten").Set(-2, "neg")
+	out, err := json.Marshal(ints)
+	if err != nil || string(out) != `{"10":"ten","-2":"neg"}` {
+		t.Fatalf("unexpected int-key JSON: %s (%v)", out, err)
+	}
+
+	var back OrderedMap[int, string]
+	if err := json.Unmarshal(out, &back); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(back.Keys().Items(), []int{10, -2}) {
+		t.Fatalf("unexpected decoded keys: %v", back.Keys().Items())
+	}
+
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	times := NewOrderedMap[time.Time, int]().Set(day, 1)
+	out, err = json.Marshal(times)
+	if err != nil || string(out) != `{"2024-01-02T00:00:00Z":1}` {
+		t.Fatalf("unexpected TextMarshaler-key JSON: %s (%v)", out, err)
+	}
+}
+
+func TestOrderedMap_JSONErrors(t *testing.T) {
+	var m OrderedMap[int, int]
+	if err := json.Unmarshal([]byte(`{"x":1}`), &m); err == nil {
+		t.Fatalf("expected error for non-integer key")
+	}
+	if err := json.Unmarshal([]byte(`[1,2]`), &m); err == nil {
+		t.Fatalf("expected error for non-object input")
+	}
+
+	type point struct{ X int }
+	if _, err := json.Marshal(NewOrderedMap[point, int]().Set(point{1}, 1)); err == nil {
+		t.Fatalf("expected error for unsupported key type")
+	}
+}
+
+func TestOrderedMap_JSONNullLeavesMapUnchanged(t *testing.T) {
+	m := NewOrderedMap[string, int]().Set("a", 1)
+
+	if err := json.Unmarshal([]byte(`null`), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !m.Has("a") {
+		t.Fatalf("null should leave the map unchanged")
+	}
+}
@@ -0,0 +1,69 @@
+package collection
+
+// Pad returns a new collection filled with value up to |size| items.
+// A positive size pads on the right and a negative size pads on the left.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// If |size| is less than or equal to the current length, the result holds
+// the same items unchanged. Like Append, the result always has its own
+// backing slice, so the original collection is never affected.
+//
+// Mirrors Laravel's pad() semantics.
+//
+// Example: integers - pad right
+//
+//	c := collection.New([]int{1, 2})
+//	collection.Dump(c.Pad(4, 0).Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 0 #int
+//	//   3 => 0 #int
+//	// ]
+//
+// Example: integers - pad left
+//
+//	collection.Dump(c.Pad(-4, 0).Items())
+//	// #[]int [
+//	//   0 => 0 #int
+//	//   1 => 0 #int
+//	//   2 => 1 #int
+//	//   3 => 2 #int
+//	// ]
+//
+// Example: strings - already long enough
+//
+//	letters := collection.New([]string{"a", "b", "c"})
+//	collection.Dump(letters.Pad(2, "-").Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	//   2 => "c" #string
+//	// ]
+func (c *Collection[T]) Pad(size int, value T) *Collection[T] {
+	left := size < 0
+	if left {
+		size = -size
+	}
+
+	n := 0
+	if size > len(c.items) {
+		n = size - len(c.items)
+	}
+	out := make([]T, 0, len(c.items)+n)
+
+	if !left {
+		out = append(out, c.items...)
+	}
+	for range n {
+		out = append(out, value)
+	}
+	if left {
+		out = append(out, c.items...)
+	}
+
+	return New(out)
+}
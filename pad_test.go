@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPad_Sizes(t *testing.T) {
+	cases := []struct {
+		size     int
+		expected []int
+	}{
+		{5, []int{1, 2, 3, 0, 0}},
+		{-5, []int{0, 0, 1, 2, 3}},
+		{3, []int{1, 2, 3}},
+		{-3, []int{1, 2, 3}},
+		{1, []int{1, 2, 3}},
+		{0, []int{1, 2, 3}},
+		{math.MinInt, []int{1, 2, 3}},
+	}
+
+	for _, tc := range cases {
+		got := New([]int{1, 2, 3}).Pad(tc.size, 0).Items()
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("Pad(%d): expected %v, got %v", tc.size, tc.expected, got)
+		}
+	}
+}
+
+func TestPad_EmptyCollection(t *testing.T) {
+	got := New([]string(nil)).Pad(-2, "x").Items()
+	if !reflect.DeepEqual(got, []string{"x", "x"}) {
+		t.Fatalf("expected [x x], got %v", got)
+	}
+}
+
+func TestPad_DoesNotMutateOriginal(t *testing.T) {
+	orig := make([]int, 2, 10)
+	orig[0], orig[1] = 1, 2
+	c := New(orig)
+
+	out := c.Pad(4, 9)
+
+	if out == c {
+		t.Fatalf("expected Pad to return a new collection")
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2}) || orig[:3][2] != 0 {
+		t.Fatalf("Pad mutated the original: %v", orig[:3])
+	}
+
+	// Even without padding the result has its own backing slice.
+	same := c.Pad(1, 9)
+	same.Items()[0] = 100
+	if orig[0] != 1 {
+		t.Fatalf("expected Pad result not to alias the original")
+	}
+}
@@ -0,0 +1,301 @@
+package collection
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Page is one page of a collection together with the bookkeeping needed to
+// render pagination controls, in the spirit of Laravel's LengthAwarePaginator.
+//
+// Items is a view over the source collection (it shares the backing array).
+// Path is the base URL used to build page links; it is empty unless set
+// with WithPath.
+type Page[T any] struct {
+	Items       *Collection[T]
+	Total       int
+	PerPage     int
+	CurrentPage int
+	LastPage    int
+	Path        string
+}
+
+// Paginate slices the collection into the requested page and returns it as
+// a Page, along with the total item count and the last page number.
+// @group Pagination
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// Pages are 1-based. A page below 1 is treated as page 1; a page past the
+// end yields an empty Items collection but keeps the requested CurrentPage.
+// LastPage is always at least 1. If perPage <= 0, Items is empty.
+//
+// Items reuses ForPage, so no elements are copied.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 3, 4, 5, 6, 7})
+//	p := c.Paginate(2, 3)
+//	collection.Dump(p.Items.Items())
+//	// #[]int [
+//	//   0 => 4 #int
+//	//   1 => 5 #int
+//	//   2 => 6 #int
+//	// ]
+//	fmt.Println(p.Total, p.CurrentPage, p.LastPage, p.HasMorePages())
+//	// 7 2 3 true
+//
+// Example: structs
+//
+//	type User struct {
+//		ID int
+//	}
+//
+//	users := collection.New([]User{{ID: 1}, {ID: 2}, {ID: 3}})
+//	last := users.Paginate(2, 2)
+//	collection.Dump(last.Items.Items())
+//	// #[]main.User [
+//	//   0 => #main.User {
+//	//     +ID => 3 #int
+//	//   }
+//	// ]
+//	fmt.Println(last.OnLastPage(), last.From(), last.To())
+//	// true 3 3
+func (c *Collection[T]) Paginate(page, perPage int) Page[T] {
+	if page < 1 {
+		page = 1
+	}
+
+	total := len(c.items)
+	lastPage := 1
+	if perPage > 0 && total > 0 {
+		lastPage = (total + perPage - 1) / perPage
+	}
+
+	return Page[T]{
+		Items:       c.ForPage(page, perPage),
+		Total:       total,
+		PerPage:     perPage,
+		CurrentPage: page,
+		LastPage:    lastPage,
+	}
+}
+
+// WithPath returns a copy of the page whose links are built from path.
+// @group Pagination
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// path should be the base URL without a page parameter; the page number is
+// appended as "?page=N", or "&page=N" if path already has a query string.
+//
+// Example: base URL
+//
+//	p := collection.New([]int{1, 2, 3}).Paginate(1, 2).WithPath("https://api.test/users")
+//	fmt.Println(p.URL(2))
+//	// https://api.test/users?page=2
+func (p Page[T]) WithPath(path string) Page[T] {
+	p.Path = path
+	return p
+}
+
+// URL returns the link to the given page number, built from Path.
+// @group Pagination
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: existing query string
+//
+//	p := collection.New([]int{1, 2, 3}).Paginate(1, 2).WithPath("/users?sort=name")
+//	fmt.Println(p.URL(2))
+//	// /users?sort=name&page=2
+func (p Page[T]) URL(page int) string {
+	sep := "?"
+	if strings.Contains(p.Path, "?") {
+		sep = "&"
+	}
+	return p.Path + sep + "page=" + strconv.Itoa(page)
+}
+
+// HasMorePages reports whether there is a page after the current one.
+// @group Pagination
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: middle page
+//
+//	p := collection.New([]int{1, 2, 3, 4, 5}).Paginate(2, 2)
+//	fmt.Println(p.HasMorePages())
+//	// true
+func (p Page[T]) HasMorePages() bool {
+	return p.CurrentPage < p.LastPage
+}
+
+// OnFirstPage reports whether the current page is the first page.
+// @group Pagination
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: first page
+//
+//	p := collection.New([]int{1, 2, 3}).Paginate(1, 2)
+//	fmt.Println(p.OnFirstPage())
+//	// true
+func (p Page[T]) OnFirstPage() bool {
+	return p.CurrentPage <= 1
+}
+
+// OnLastPage reports whether the current page is the last page or beyond it.
+// @group Pagination
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: last page
+//
+//	p := collection.New([]int{1, 2, 3}).Paginate(2, 2)
+//	fmt.Println(p.OnLastPage())
+//	// true
+func (p Page[T]) OnLastPage() bool {
+	return p.CurrentPage >= p.LastPage
+}
+
+// From returns the 1-based position of the first item on the page, or 0 if
+// the page is empty.
+// @group Pagination
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: second page
+//
+//	p := collection.New([]int{10, 20, 30, 40, 50}).Paginate(2, 2)
+//	fmt.Println(p.From(), p.To())
+//	// 3 4
+func (p Page[T]) From() int {
+	if p.Items == nil || p.Items.IsEmpty() {
+		return 0
+	}
+	return (p.CurrentPage-1)*p.PerPage + 1
+}
+
+// To returns the 1-based position of the last item on the page, or 0 if the
+// page is empty.
+// @group Pagination
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: partial last page
+//
+//	p := collection.New([]int{10, 20, 30, 40, 50}).Paginate(3, 2)
+//	fmt.Println(p.From(), p.To())
+//	// 5 5
+func (p Page[T]) To() int {
+	if p.Items == nil || p.Items.IsEmpty() {
+		return 0
+	}
+	return p.From() + p.Items.Count() - 1
+}
+
+type pageLinks struct {
+	First string  `json:"first"`
+	Last  string  `json:"last"`
+	Prev  *string `json:"prev"`
+	Next  *string `json:"next"`
+}
+
+type pageMeta struct {
+	CurrentPage int    `json:"current_page"`
+	From        *int   `json:"from"`
+	LastPage    int    `json:"last_page"`
+	Path        string `json:"path"`
+	PerPage     int    `json:"per_page"`
+	To          *int   `json:"to"`
+	Total       int    `json:"total"`
+}
+
+// MarshalJSON encodes the page using Laravel's paginated resource envelope:
+// the items under "data", page links under "links" and counts under "meta".
+// @group Serialization
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Links are built from Path (see WithPath). "prev" and "next" are null on
+// the first and last page (past the end, "prev" points at the last page),
+// and "from" and "to" are null when the page is empty. An empty page encodes "data" as [] rather than null.
+//
+// Example: API response
+//
+//	users := collection.New([]string{"ann", "bob", "cat"})
+//	p := users.Paginate(1, 2).WithPath("https://api.test/users")
+//	out, _ := json.MarshalIndent(p, "", "  ")
+//	fmt.Println(string(out))
+//	// {
+//	//   "data": [
+//	//     "ann",
+//	//     "bob"
+//	//   ],
+//	//   "links": {
+//	//     "first": "https://api.test/users?page=1",
+//	//     "last": "https://api.test/users?page=2",
+//	//     "prev": null,
+//	//     "next": "https://api.test/users?page=2"
+//	//   },
+//	//   "meta": {
+//	//     "current_page": 1,
+//	//     "from": 1,
+//	//     "last_page": 2,
+//	//     "path": "https://api.test/users",
+//	//     "per_page": 2,
+//	//     "to": 2,
+//	//     "total": 3
+//	//   }
+//	// }
+func (p Page[T]) MarshalJSON() ([]byte, error) {
+	var data []T
+	if p.Items != nil {
+		data = p.Items.items
+	}
+	if data == nil {
+		data = []T{}
+	}
+
+	links := pageLinks{
+		First: p.URL(1),
+		Last:  p.URL(p.LastPage),
+	}
+	if p.CurrentPage > 1 {
+		prev := p.URL(min(p.CurrentPage-1, p.LastPage))
+		links.Prev = &prev
+	}
+	if p.HasMorePages() {
+		next := p.URL(p.CurrentPage + 1)
+		links.Next = &next
+	}
+
+	meta := pageMeta{
+		CurrentPage: p.CurrentPage,
+		LastPage:    p.LastPage,
+		Path:        p.Path,
+		PerPage:     p.PerPage,
+		Total:       p.Total,
+	}
+	if from, to := p.From(), p.To(); from > 0 {
+		meta.From = &from
+		meta.To = &to
+	}
+
+	return json.Marshal(struct {
+		Data  []T       `json:"data"`
+		Links pageLinks `json:"links"`
+		Meta  pageMeta  `json:"meta"`
+	}{data, links, meta})
+}
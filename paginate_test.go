@@ -0,0 +1,125 @@
+package collection
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPaginate_Fields(t *testing.T) {
+	c := New([]int{1, 2, 3, 4, 5, 6, 7})
+
+	p := c.Paginate(2, 3)
+
+	if !reflect.DeepEqual(p.Items.Items(), []int{4, 5, 6}) {
+		t.Fatalf("unexpected items: %v", p.Items.Items())
+	}
+	if p.Total != 7 || p.PerPage != 3 || p.CurrentPage != 2 || p.LastPage != 3 {
+		t.Fatalf("unexpected page: %+v", p)
+	}
+	if !p.HasMorePages() || p.OnFirstPage() || p.OnLastPage() {
+		t.Fatalf("unexpected flags for middle page")
+	}
+	if p.From() != 4 || p.To() != 6 {
+		t.Fatalf("expected from 4 to 6, got %d to %d", p.From(), p.To())
+	}
+}
+
+func TestPaginate_EdgePages(t *testing.T) {
+	c := New([]int{1, 2, 3, 4, 5})
+
+	first := c.Paginate(0, 2)
+	if first.CurrentPage != 1 || !first.OnFirstPage() || !first.HasMorePages() {
+		t.Fatalf("unexpected first page: %+v", first)
+	}
+
+	last := c.Paginate(3, 2)
+	if !last.OnLastPage() || last.HasMorePages() || last.From() != 5 || last.To() != 5 {
+		t.Fatalf("unexpected last page: %+v", last)
+	}
+
+	beyond := c.Paginate(9, 2)
+	if beyond.CurrentPage != 9 || beyond.LastPage != 3 || !beyond.Items.IsEmpty() {
+		t.Fatalf("unexpected page beyond the end: %+v", beyond)
+	}
+	if beyond.From() != 0 || beyond.To() != 0 || beyond.HasMorePages() {
+		t.Fatalf("expected empty bounds beyond the end")
+	}
+}
+
+func TestPaginate_EmptyAndInvalidPerPage(t *testing.T) {
+	empty := New([]int{}).Paginate(1, 10)
+	if empty.Total != 0 || empty.LastPage != 1 || !empty.OnLastPage() {
+		t.Fatalf("unexpected empty page: %+v", empty)
+	}
+
+	zero := New([]int{1, 2}).Paginate(1, 0)
+	if zero.LastPage != 1 || !zero.Items.IsEmpty() || zero.Total != 2 {
+		t.Fatalf("unexpected page for perPage 0: %+v", zero)
+	}
+}
+
+func TestPage_URL(t *testing.T) {
+	p := New([]int{1}).Paginate(1, 1)
+
+	if got := p.URL(2); got != "?page=2" {
+		t.Fatalf("expected ?page=2 without a path, got %s", got)
+	}
+	if got := p.WithPath("/users").URL(3); got != "/users?page=3" {
+		t.Fatalf("unexpected url: %s", got)
+	}
+	if got := p.WithPath("/users?q=a").URL(3); got != "/users?q=a&page=3" {
+		t.Fatalf("unexpected url: %s", got)
+	}
+	if p.Path != "" {
+		t.Fatalf("WithPath should not modify the original page")
+	}
+}
+
+func TestPage_MarshalJSON_MiddlePage(t *testing.T) {
+	p := New([]string{"a", "b", "c", "d", "e"}).Paginate(2, 2).WithPath("/items")
+
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"data":["c","d"],` +
+		`"links":{"first":"/items?page=1","last":"/items?page=3","prev":"/items?page=1","next":"/items?page=3"},` +
+		`"meta":{"current_page":2,"from":3,"last_page":3,"path":"/items","per_page":2,"to":4,"total":5}}`
+	if string(out) != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, out)
+	}
+}
+
+func TestPage_MarshalJSON_EmptyPage(t *testing.T) {
+	p := New([]int{1, 2, 3}).Paginate(5, 2).WithPath("/n")
+
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"data":[],` +
+		`"links":{"first":"/n?page=1","last":"/n?page=2","prev":"/n?page=2","next":null},` +
+		`"meta":{"current_page":5,"from":null,"last_page":2,"path":"/n","per_page":2,"to":null,"total":3}}`
+	if string(out) != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, out)
+	}
+}
+
+func TestPage_MarshalJSON_ZeroValue(t *testing.T) {
+	var p Page[int]
+
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		Data []int `json:"data"`
+	}
+	if err := json.Unmarshal(out, &decoded); err != nil || decoded.Data == nil {
+		t.Fatalf("expected data to be an empty array, got %s", out)
+	}
+}
@@ -0,0 +1,246 @@
+package collection
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelMapTo maps a Collection[T] to a Collection[R] using up to workers
+// goroutines.
+// @group Parallel
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Output order always matches input order. If workers <= 0, GOMAXPROCS is used.
+// If fn panics in any worker, remaining work is abandoned and the panic is
+// re-raised in the calling goroutine once all workers have stopped.
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: integers - squares
+//
+//	nums := collection.New([]int{1, 2, 3, 4})
+//	squares := collection.ParallelMapTo(nums, 2, func(n int) int {
+//		return n * n
+//	})
+//	collection.Dump(squares.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 4 #int
+//	//   2 => 9 #int
+//	//   3 => 16 #int
+//	// ]
+//
+// Example: strings - CPU-heavy formatting
+//
+//	words := collection.New([]string{"go", "forj"})
+//	upper := collection.ParallelMapTo(words, 0, strings.ToUpper)
+//	collection.Dump(upper.Items())
+//	// #[]string [
+//	//   0 => "GO" #string
+//	//   1 => "FORJ" #string
+//	// ]
+func ParallelMapTo[T any, R any](c *Collection[T], workers int, fn func(T) R) *Collection[R] {
+	items := c.items
+	out := make([]R, len(items))
+
+	parallelFor(len(items), workers, func(i int) {
+		out[i] = fn(items[i])
+	})
+
+	return New(out)
+}
+
+// ParallelFilter keeps only the elements for which fn returns true,
+// evaluating fn on up to workers goroutines.
+// This method mutates the collection in place and returns the same instance.
+// @group Parallel
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Predicates run concurrently; compaction happens afterwards on the calling
+// goroutine, so the relative order of kept items is preserved exactly as in
+// Filter. If workers <= 0, GOMAXPROCS is used. Worker panics are re-raised
+// in the caller and leave the collection unchanged.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 3, 4, 5, 6})
+//	c.ParallelFilter(3, func(v int) bool { return v%2 == 0 })
+//	collection.Dump(c.Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 4 #int
+//	//   2 => 6 #int
+//	// ]
+func (c *Collection[T]) ParallelFilter(workers int, fn func(T) bool) *Collection[T] {
+	items := c.items
+	keep := make([]bool, len(items))
+
+	parallelFor(len(items), workers, func(i int) {
+		keep[i] = fn(items[i])
+	})
+
+	j := 0
+	for i := range items {
+		if keep[i] {
+			items[j] = items[i]
+			j++
+		}
+	}
+
+	clear(items[j:])
+	c.items = items[:j]
+	return c
+}
+
+// ParallelEach runs fn for every item using up to workers goroutines and
+// returns the same collection.
+// @group Parallel
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Items are visited in no particular order; fn must be safe for concurrent use.
+// ParallelEach returns only after every call has finished. If workers <= 0,
+// GOMAXPROCS is used. Worker panics are re-raised in the caller.
+//
+// Example: integers - concurrent sum
+//
+//	var total atomic.Int64
+//	collection.New([]int{1, 2, 3, 4}).ParallelEach(2, func(v int) {
+//		total.Add(int64(v))
+//	})
+//	collection.Dump(total.Load())
+//	// 10 #int64
+func (c *Collection[T]) ParallelEach(workers int, fn func(T)) *Collection[T] {
+	items := c.items
+
+	parallelFor(len(items), workers, func(i int) {
+		fn(items[i])
+	})
+
+	return c
+}
+
+// ParallelReduce collapses the collection into a single value using up to
+// workers goroutines.
+// @group Parallel
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The collection is split into contiguous segments that are reduced
+// concurrently, then the segment results are folded left to right starting
+// from initial. fn must therefore be associative (sum, max, concatenation);
+// for such functions the result equals Reduce. If workers <= 0, GOMAXPROCS
+// is used. Worker panics are re-raised in the caller.
+//
+// Example: integers - sum
+//
+//	sum := collection.New([]int{1, 2, 3, 4, 5}).ParallelReduce(2, 0, func(a, b int) int {
+//		return a + b
+//	})
+//	collection.Dump(sum)
+//	// 15 #int
+//
+// Example: strings - order-preserving concatenation
+//
+//	joined := collection.New([]string{"a", "b", "c", "d"}).ParallelReduce(4, "", func(a, b string) string {
+//		return a + b
+//	})
+//	collection.Dump(joined)
+//	// "abcd" #string
+func (c *Collection[T]) ParallelReduce(workers int, initial T, fn func(T, T) T) T {
+	items := c.items
+	n := len(items)
+	if n == 0 {
+		return initial
+	}
+
+	segments := resolveWorkers(workers, n)
+	size := (n + segments - 1) / segments
+	segments = (n + size - 1) / size
+	partial := make([]T, segments)
+
+	parallelFor(segments, segments, func(s int) {
+		start := s * size
+		end := min(start+size, n)
+
+		acc := items[start]
+		for _, v := range items[start+1 : end] {
+			acc = fn(acc, v)
+		}
+		partial[s] = acc
+	})
+
+	acc := initial
+	for _, v := range partial {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// resolveWorkers clamps the requested worker count to [1, n], defaulting to
+// GOMAXPROCS when workers <= 0.
+func resolveWorkers(workers, n int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	return workers
+}
+
+// parallelFor calls fn(i) for every i in [0, n) on a bounded pool of
+// goroutines. Indices are handed out dynamically so uneven workloads stay
+// balanced. The first panic raised by fn stops further dispatch and is
+// re-raised on the calling goroutine after all workers exit.
+func parallelFor(n, workers int, fn func(i int)) {
+	if n == 0 {
+		return
+	}
+
+	workers = resolveWorkers(workers, n)
+
+	var (
+		next     atomic.Int64
+		stopped  atomic.Bool
+		wg       sync.WaitGroup
+		once     sync.Once
+		panicVal any
+	)
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() { panicVal = r })
+					stopped.Store(true)
+				}
+			}()
+
+			for !stopped.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stopped.Load() {
+		panic(panicVal)
+	}
+}
@@ -0,0 +1,194 @@
+package collection
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMapTo_PreservesOrder(t *testing.T) {
+	items := make([]int, 500)
+	for i := range items {
+		items[i] = i
+	}
+
+	out := ParallelMapTo(New(items), 8, func(v int) int {
+		if v%7 == 0 {
+			time.Sleep(time.Microsecond)
+		}
+		return v * 2
+	})
+
+	for i, v := range out.Items() {
+		if v != i*2 {
+			t.Fatalf("index %d: expected %d, got %d", i, i*2, v)
+		}
+	}
+}
+
+func TestParallelMapTo_DoesNotMutateSource(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	_ = ParallelMapTo(New(items), 2, func(v int) int { return v * 10 })
+
+	if !reflect.DeepEqual(items, []int{1, 2, 3}) {
+		t.Fatalf("source mutated: %v", items)
+	}
+}
+
+func TestParallelMapTo_Empty(t *testing.T) {
+	out := ParallelMapTo(New([]int{}), 4, func(v int) string { return "x" })
+
+	if len(out.Items()) != 0 {
+		t.Fatalf("expected empty result, got %v", out.Items())
+	}
+}
+
+func TestParallelMapTo_RespectsWorkerLimit(t *testing.T) {
+	items := make([]int, 64)
+
+	var active, peak atomic.Int32
+	ParallelMapTo(New(items), 3, func(v int) int {
+		n := active.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(100 * time.Microsecond)
+		active.Add(-1)
+		return v
+	})
+
+	if peak.Load() > 3 {
+		t.Fatalf("expected at most 3 concurrent workers, saw %d", peak.Load())
+	}
+}
+
+func TestParallelMapTo_PropagatesPanic(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r != "boom" {
+			t.Fatalf(`expected panic "boom", got %v`, r)
+		}
+	}()
+
+	ParallelMapTo(New([]int{1, 2, 3, 4}), 2, func(v int) int {
+		if v == 3 {
+			panic("boom")
+		}
+		return v
+	})
+
+	t.Fatalf("expected panic")
+}
+
+func TestParallelFilter_MatchesFilter(t *testing.T) {
+	items := make([]int, 200)
+	for i := range items {
+		items[i] = i
+	}
+	pred := func(v int) bool { return v%3 == 0 }
+
+	expected := New(append([]int(nil), items...)).Filter(pred).Items()
+	got := New(items).ParallelFilter(4, pred).Items()
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestParallelFilter_ReturnsSameInstance(t *testing.T) {
+	c := New([]int{1, 2, 3})
+
+	if out := c.ParallelFilter(2, func(int) bool { return true }); out != c {
+		t.Fatalf("ParallelFilter should return the same collection")
+	}
+}
+
+func TestParallelFilter_ClearsRemovedTail(t *testing.T) {
+	a, b := 1, 2
+	items := []*int{&a, &b}
+
+	New(items).ParallelFilter(2, func(p *int) bool { return *p == 1 })
+
+	if items[1] != nil {
+		t.Fatalf("expected removed tail to be cleared")
+	}
+}
+
+func TestParallelFilter_PanicLeavesCollectionUnchanged(t *testing.T) {
+	items := []int{1, 2, 3}
+	c := New(items)
+
+	func() {
+		defer func() { _ = recover() }()
+		c.ParallelFilter(2, func(v int) bool {
+			if v == 2 {
+				panic("boom")
+			}
+			return false
+		})
+	}()
+
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3}) {
+		t.Fatalf("expected collection unchanged after panic, got %v", c.Items())
+	}
+}
+
+func TestParallelEach_VisitsEveryItem(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[int]int{}
+
+	c := New([]int{1, 2, 3, 4, 5})
+	out := c.ParallelEach(0, func(v int) {
+		mu.Lock()
+		seen[v]++
+		mu.Unlock()
+	})
+
+	if out != c {
+		t.Fatalf("ParallelEach should return the same collection")
+	}
+	for _, v := range c.Items() {
+		if seen[v] != 1 {
+			t.Fatalf("expected %d to be visited once, got %d", v, seen[v])
+		}
+	}
+}
+
+func TestParallelReduce_MatchesReduce(t *testing.T) {
+	items := make([]int, 1001)
+	for i := range items {
+		items[i] = i
+	}
+	c := New(items)
+	add := func(a, b int) int { return a + b }
+
+	for _, workers := range []int{-1, 0, 1, 3, 8, 2000} {
+		if got, want := c.ParallelReduce(workers, 7, add), c.Reduce(7, add); got != want {
+			t.Fatalf("workers=%d: expected %d, got %d", workers, want, got)
+		}
+	}
+}
+
+func TestParallelReduce_PreservesOrderForAssociativeFn(t *testing.T) {
+	c := New([]string{"a", "b", "c", "d", "e", "f", "g"})
+
+	got := c.ParallelReduce(3, ">", func(a, b string) string { return a + b })
+
+	if got != ">abcdefg" {
+		t.Fatalf(`expected ">abcdefg", got %q`, got)
+	}
+}
+
+func TestParallelReduce_EmptyReturnsInitial(t *testing.T) {
+	got := New([]int{}).ParallelReduce(4, 42, func(a, b int) int { return a + b })
+
+	if got != 42 {
+		t.Fatalf("expected 42, got %d", got)
+	}
+}
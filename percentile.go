@@ -0,0 +1,183 @@
+package collection
+
+import (
+	"math"
+	"slices"
+)
+
+// QuantileMethod selects how Percentile and Quantiles pick or interpolate a
+// value between the sorted data points.
+type QuantileMethod int
+
+const (
+	// QuantileR7 interpolates linearly between the order statistics at
+	// rank (n-1)·p + 1 (Hyndman & Fan type 7). This is the default used by
+	// R, NumPy and spreadsheet PERCENTILE / PERCENTILE.INC.
+	QuantileR7 QuantileMethod = iota
+
+	// QuantileNearestRank returns the smallest value whose rank is at least
+	// n·p, without interpolation. The result is always an observed value.
+	QuantileNearestRank
+
+	// QuantileR4 interpolates linearly on the empirical distribution
+	// function, at rank n·p (Hyndman & Fan type 4). This is not NumPy's
+	// method="linear", which is type 7 (QuantileR7): the median of
+	// [1 2 3 4] is 2 here, but 2.5 under R-7.
+	QuantileR4
+)
+
+// Percentile returns the p-th percentile of the collection, for p in
+// [0, 100], using the QuantileR7 method.
+// Returns (0, false) if the collection is empty or p is out of range.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The collection is copied and sorted; the borrowed slice is never mutated.
+//
+// Example: p95 latency
+//
+//	latencies := collection.NewNumeric([]int{120, 80, 95, 300, 110, 90, 105, 85, 100, 250})
+//	p95, ok := latencies.Percentile(95)
+//	collection.Dump(p95, ok)
+//	// 277.500000 #float64
+//	// true #bool
+func (c *NumericCollection[T]) Percentile(p float64) (float64, bool) {
+	return c.PercentileWith(p, QuantileR7)
+}
+
+// PercentileWith returns the p-th percentile of the collection, for p in
+// [0, 100], using the given interpolation method.
+// Returns (0, false) if the collection is empty, p is out of range, or the
+// method is unknown.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: nearest-rank vs R-7
+//
+//	c := collection.NewNumeric([]int{15, 20, 35, 40, 50})
+//	nr, _ := c.PercentileWith(40, collection.QuantileNearestRank)
+//	r7, _ := c.PercentileWith(40, collection.QuantileR7)
+//	collection.Dump(nr, r7)
+//	// 20.000000 #float64
+//	// 29.000000 #float64
+func (c *NumericCollection[T]) PercentileWith(p float64, method QuantileMethod) (float64, bool) {
+	out, ok := c.QuantilesWith(method, p/100)
+	if !ok {
+		return 0, false
+	}
+	return out[0], true
+}
+
+// Quantiles returns the quantiles of the collection at each p in ps, where
+// every p is in [0, 1], using the QuantileR7 method.
+// Returns (nil, false) if the collection is empty or any p is out of range.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The collection is copied and sorted once, however many quantiles are
+// requested. Results are returned in the same order as ps.
+//
+// Example: quartiles
+//
+//	c := collection.NewNumeric([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+//	qs, ok := c.Quantiles(0.25, 0.5, 0.75)
+//	collection.Dump(qs, ok)
+//	// #[]float64 [
+//	//   0 => 3.000000 #float64
+//	//   1 => 5.000000 #float64
+//	//   2 => 7.000000 #float64
+//	// ]
+//	// true #bool
+func (c *NumericCollection[T]) Quantiles(ps ...float64) ([]float64, bool) {
+	return c.QuantilesWith(QuantileR7, ps...)
+}
+
+// QuantilesWith returns the quantiles of the collection at each p in ps,
+// where every p is in [0, 1], using the given interpolation method.
+// Returns (nil, false) if the collection is empty, any p is out of range,
+// or the method is unknown.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: Hyndman & Fan type 4
+//
+//	c := collection.NewNumeric([]float64{10, 20, 30, 40})
+//	qs, _ := c.QuantilesWith(collection.QuantileR4, 0.25, 0.5, 0.9)
+//	collection.Dump(qs)
+//	// #[]float64 [
+//	//   0 => 10.000000 #float64
+//	//   1 => 20.000000 #float64
+//	//   2 => 36.000000 #float64
+//	// ]
+func (c *NumericCollection[T]) QuantilesWith(method QuantileMethod, ps ...float64) ([]float64, bool) {
+	if len(c.items) == 0 || method < QuantileR7 || method > QuantileR4 {
+		return nil, false
+	}
+	for _, p := range ps {
+		if !(p >= 0 && p <= 1) {
+			return nil, false
+		}
+	}
+
+	sorted := sortedFloats(c.items)
+
+	out := make([]float64, len(ps))
+	for i, p := range ps {
+		out[i] = quantile(sorted, p, method)
+	}
+	return out, true
+}
+
+// sortedFloats returns the items converted to float64 in a new, sorted slice.
+func sortedFloats[T Number](items []T) []float64 {
+	out := make([]float64, len(items))
+	for i, v := range items {
+		out[i] = float64(v)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// quantile computes the p-quantile of the non-empty, sorted slice s.
+func quantile(s []float64, p float64, method QuantileMethod) float64 {
+	n := float64(len(s))
+
+	// h is the 1-based (fractional) rank of the quantile.
+	var h float64
+	switch method {
+	case QuantileNearestRank:
+		if p == 0 {
+			return s[0]
+		}
+		// Snap ranks within rounding error of an integer, so that
+		// p = 7/100 with n = 100 selects rank 7 rather than 8.
+		r := p * n
+		if math.Abs(r-math.Round(r)) < 1e-9 {
+			r = math.Round(r)
+		}
+		return s[min(int(math.Ceil(r)), len(s))-1]
+	case QuantileR4:
+		h = n * p
+	default:
+		h = (n-1)*p + 1
+	}
+
+	if h <= 1 {
+		return s[0]
+	}
+	if h >= n {
+		return s[len(s)-1]
+	}
+
+	lo := math.Floor(h)
+	i := int(lo) - 1
+	return s[i] + (h-lo)*(s[i+1]-s[i])
+}
@@ -0,0 +1,120 @@
+package collection
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPercentile_R7MatchesKnownValues(t *testing.T) {
+	c := NewNumeric([]int{15, 20, 35, 40, 50})
+
+	cases := map[float64]float64{0: 15, 25: 20, 40: 29, 50: 35, 90: 46, 100: 50}
+	for p, want := range cases {
+		got, ok := c.Percentile(p)
+		if !ok || math.Abs(got-want) > 1e-9 {
+			t.Fatalf("p=%v: expected %v, got %v (ok=%v)", p, want, got, ok)
+		}
+	}
+}
+
+func TestPercentileWith_NearestRank(t *testing.T) {
+	c := NewNumeric([]int{15, 20, 35, 40, 50})
+
+	cases := map[float64]float64{0: 15, 5: 15, 30: 20, 40: 20, 50: 35, 100: 50}
+	for p, want := range cases {
+		got, ok := c.PercentileWith(p, QuantileNearestRank)
+		if !ok || got != want {
+			t.Fatalf("p=%v: expected %v, got %v (ok=%v)", p, want, got, ok)
+		}
+	}
+}
+
+func TestPercentileWith_NearestRankExactRanks(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i + 1
+	}
+	c := NewNumeric(items)
+
+	for p := 1; p <= 100; p++ {
+		got, _ := c.PercentileWith(float64(p), QuantileNearestRank)
+		if got != float64(p) {
+			t.Fatalf("p=%d: expected %d, got %v", p, p, got)
+		}
+	}
+}
+
+func TestQuantilesWith_R4(t *testing.T) {
+	c := NewNumeric([]float64{10, 20, 30, 40})
+
+	got, ok := c.QuantilesWith(QuantileR4, 0, 0.1, 0.25, 0.5, 0.9, 1)
+
+	if !ok || !reflect.DeepEqual(got, []float64{10, 10, 10, 20, 36, 40}) {
+		t.Fatalf("unexpected quantiles: %v (ok=%v)", got, ok)
+	}
+}
+
+func TestPercentileWith_R4DiffersFromNumPyLinear(t *testing.T) {
+	c := NewNumeric([]int{1, 2, 3, 4})
+
+	r4, _ := c.PercentileWith(50, QuantileR4)
+	r7, _ := c.PercentileWith(50, QuantileR7)
+
+	if r4 != 2 || r7 != 2.5 {
+		t.Fatalf("expected R4=2 and R7=2.5, got %v and %v", r4, r7)
+	}
+}
+
+func TestQuantiles_PreservesRequestOrder(t *testing.T) {
+	c := NewNumeric([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	got, ok := c.Quantiles(0.75, 0.25, 0.5)
+
+	if !ok || !reflect.DeepEqual(got, []float64{7, 3, 5}) {
+		t.Fatalf("expected [7 3 5], got %v (ok=%v)", got, ok)
+	}
+}
+
+func TestQuantiles_DoesNotMutate(t *testing.T) {
+	items := []int{5, 3, 9, 1}
+	c := NewNumeric(items)
+
+	_, _ = c.Quantiles(0.5)
+	_, _ = c.Percentile(90)
+
+	if !reflect.DeepEqual(items, []int{5, 3, 9, 1}) {
+		t.Fatalf("borrowed slice was mutated: %v", items)
+	}
+}
+
+func TestQuantiles_InvalidInput(t *testing.T) {
+	c := NewNumeric([]int{1, 2, 3})
+
+	if _, ok := NewNumeric([]int{}).Quantiles(0.5); ok {
+		t.Fatalf("expected ok=false for empty collection")
+	}
+	if _, ok := c.Quantiles(0.5, 1.5); ok {
+		t.Fatalf("expected ok=false for p > 1")
+	}
+	if _, ok := c.Quantiles(math.NaN()); ok {
+		t.Fatalf("expected ok=false for NaN")
+	}
+	if _, ok := c.Percentile(-1); ok {
+		t.Fatalf("expected ok=false for p < 0")
+	}
+	if _, ok := c.QuantilesWith(QuantileMethod(99), 0.5); ok {
+		t.Fatalf("expected ok=false for unknown method")
+	}
+}
+
+func TestQuantiles_SingleItem(t *testing.T) {
+	c := NewNumeric([]int{42})
+
+	for _, m := range []QuantileMethod{QuantileR7, QuantileNearestRank, QuantileR4} {
+		got, ok := c.QuantilesWith(m, 0, 0.5, 1)
+		if !ok || !reflect.DeepEqual(got, []float64{42, 42, 42}) {
+			t.Fatalf("method %d: unexpected %v", m, got)
+		}
+	}
+}
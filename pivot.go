@@ -0,0 +1,365 @@
+package collection
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PivotTable is a matrix of aggregated values built by Pivot.
+//
+// Cells[i][j] holds the aggregate for Rows[i] and Columns[j]. RowTotals,
+// ColumnTotals and GrandTotal are computed by applying the same aggregate to
+// every item in the row, the column, or the whole collection, so they are
+// correct for non-additive aggregates such as averages.
+//
+// Headers are in first-appearance order unless reordered with SortRows or
+// SortColumns. Cells with no items hold the zero value of V until Fill is
+// called. PivotTable marshals to JSON using its field tags.
+type PivotTable[R comparable, C comparable, V any] struct {
+	Rows         []R   `json:"rows"`
+	Columns      []C   `json:"columns"`
+	Cells        [][]V `json:"cells"`
+	RowTotals    []V   `json:"row_totals"`
+	ColumnTotals []V   `json:"column_totals"`
+	GrandTotal   V     `json:"grand_total"`
+
+	present [][]bool
+}
+
+// Pivot builds a crosstab from the collection: items are grouped by rowKey
+// and colKey, and each group is reduced to a cell value with agg.
+// @group Grouping
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Row and column headers keep the order in which keys first appear, so the
+// result is deterministic, unlike GroupBy. agg is only called with non-empty
+// groups. Items within a group keep their original order.
+//
+// This cannot be a method because methods can't introduce new type parameters.
+//
+// Example: errors per region and month
+//
+//	type Event struct {
+//		Region string
+//		Month  string
+//		Errors int
+//	}
+//
+//	events := collection.New([]Event{
+//		{"us-east", "jan", 3},
+//		{"us-west", "jan", 1},
+//		{"us-east", "feb", 4},
+//		{"us-east", "jan", 2},
+//	})
+//
+//	sumErrors := func(es []Event) int {
+//		total := 0
+//		for _, e := range es {
+//			total += e.Errors
+//		}
+//		return total
+//	}
+//
+//	table := collection.Pivot(events,
+//		func(e Event) string { return e.Region },
+//		func(e Event) string { return e.Month },
+//		sumErrors,
+//	)
+//
+//	for _, row := range table.ToStrings() {
+//		fmt.Println(strings.Join(row, " | "))
+//	}
+//	//  | jan | feb | Total
+//	// us-east | 5 | 4 | 9
+//	// us-west | 1 | 0 | 1
+//	// Total | 6 | 4 | 10
+//
+// Example: JSON export
+//
+//	out, _ := json.Marshal(table)
+//	fmt.Println(string(out))
+//	// {"rows":["us-east","us-west"],"columns":["jan","feb"],"cells":[[5,4],[1,0]],"row_totals":[9,1],"column_totals":[6,4],"grand_total":10}
+func Pivot[T any, R comparable, C comparable, V any](
+	c *Collection[T],
+	rowKey func(T) R,
+	colKey func(T) C,
+	agg func([]T) V,
+) *PivotTable[R, C, V] {
+	rowIdx := map[R]int{}
+	colIdx := map[C]int{}
+	rows := []R{}
+	cols := []C{}
+
+	var rowItems, colItems [][]T
+	cellItems := map[[2]int][]T{}
+
+	for _, v := range c.items {
+		r, col := rowKey(v), colKey(v)
+
+		i, ok := rowIdx[r]
+		if !ok {
+			i = len(rows)
+			rowIdx[r] = i
+			rows = append(rows, r)
+			rowItems = append(rowItems, nil)
+		}
+		j, ok := colIdx[col]
+		if !ok {
+			j = len(cols)
+			colIdx[col] = j
+			cols = append(cols, col)
+			colItems = append(colItems, nil)
+		}
+
+		rowItems[i] = append(rowItems[i], v)
+		colItems[j] = append(colItems[j], v)
+		cellItems[[2]int{i, j}] = append(cellItems[[2]int{i, j}], v)
+	}
+
+	t := &PivotTable[R, C, V]{
+		Rows:         rows,
+		Columns:      cols,
+		Cells:        make([][]V, len(rows)),
+		RowTotals:    make([]V, len(rows)),
+		ColumnTotals: make([]V, len(cols)),
+		present:      make([][]bool, len(rows)),
+	}
+
+	for i := range rows {
+		t.Cells[i] = make([]V, len(cols))
+		t.present[i] = make([]bool, len(cols))
+		for j := range cols {
+			if items, ok := cellItems[[2]int{i, j}]; ok {
+				t.Cells[i][j] = agg(items)
+				t.present[i][j] = true
+			}
+		}
+		t.RowTotals[i] = agg(rowItems[i])
+	}
+	for j := range cols {
+		t.ColumnTotals[j] = agg(colItems[j])
+	}
+	if len(c.items) > 0 {
+		t.GrandTotal = agg(c.items)
+	}
+
+	return t
+}
+
+// Has reports whether the cell at row i and column j was built from at
+// least one item.
+// @group Grouping
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: checking for missing cells
+//
+//	type Sale struct {
+//		Store   string
+//		Product string
+//	}
+//
+//	sales := collection.New([]Sale{{"north", "tea"}, {"south", "coffee"}})
+//	count := func(s []Sale) int { return len(s) }
+//
+//	table := collection.Pivot(sales,
+//		func(s Sale) string { return s.Store },
+//		func(s Sale) string { return s.Product },
+//		count,
+//	)
+//	fmt.Println(table.Has(0, 0), table.Has(0, 1))
+//	// true false
+func (t *PivotTable[R, C, V]) Has(i, j int) bool {
+	return t.present[i][j]
+}
+
+// Fill sets every cell that has no items to value and returns the same table.
+// This method mutates the table in place.
+// @group Grouping
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Totals are not affected.
+//
+// Example: marking gaps
+//
+//	type Sale struct {
+//		Store   string
+//		Product string
+//	}
+//
+//	sales := collection.New([]Sale{{"north", "tea"}, {"south", "coffee"}})
+//	count := func(s []Sale) int { return len(s) }
+//
+//	table := collection.Pivot(sales,
+//		func(s Sale) string { return s.Store },
+//		func(s Sale) string { return s.Product },
+//		count,
+//	).Fill(-1)
+//	fmt.Println(table.Cells)
+//	// [[1 -1] [-1 1]]
+func (t *PivotTable[R, C, V]) Fill(value V) *PivotTable[R, C, V] {
+	for i := range t.Cells {
+		for j := range t.Cells[i] {
+			if !t.present[i][j] {
+				t.Cells[i][j] = value
+			}
+		}
+	}
+	return t
+}
+
+// SortRows reorders the rows so that row keys follow less, and returns the
+// same table.
+// This method mutates the table in place.
+// @group Grouping
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// The sort is stable. Cells and RowTotals move with their rows.
+//
+// Example: alphabetical rows
+//
+//	type Hit struct{ Host, Code string }
+//
+//	hits := collection.New([]Hit{{"web-2", "200"}, {"web-1", "500"}})
+//	count := func(h []Hit) int { return len(h) }
+//
+//	table := collection.Pivot(hits,
+//		func(h Hit) string { return h.Host },
+//		func(h Hit) string { return h.Code },
+//		count,
+//	).SortRows(func(a, b string) bool { return a < b })
+//	fmt.Println(table.Rows, table.Cells)
+//	// [web-1 web-2] [[0 1] [1 0]]
+func (t *PivotTable[R, C, V]) SortRows(less func(a, b R) bool) *PivotTable[R, C, V] {
+	order := sortedOrder(t.Rows, less)
+
+	t.Rows = permute(t.Rows, order)
+	t.Cells = permute(t.Cells, order)
+	t.RowTotals = permute(t.RowTotals, order)
+	t.present = permute(t.present, order)
+	return t
+}
+
+// SortColumns reorders the columns so that column keys follow less, and
+// returns the same table.
+// This method mutates the table in place.
+// @group Grouping
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// The sort is stable. Cells and ColumnTotals move with their columns.
+//
+// Example: numeric column order
+//
+//	type Reading struct{ Sensor, Hour int }
+//
+//	readings := collection.New([]Reading{{1, 14}, {1, 9}, {2, 14}})
+//	count := func(r []Reading) int { return len(r) }
+//
+//	table := collection.Pivot(readings,
+//		func(r Reading) int { return r.Sensor },
+//		func(r Reading) int { return r.Hour },
+//		count,
+//	).SortColumns(func(a, b int) bool { return a < b })
+//	fmt.Println(table.Columns, table.Cells)
+//	// [9 14] [[1 1] [0 1]]
+func (t *PivotTable[R, C, V]) SortColumns(less func(a, b C) bool) *PivotTable[R, C, V] {
+	order := sortedOrder(t.Columns, less)
+
+	t.Columns = permute(t.Columns, order)
+	t.ColumnTotals = permute(t.ColumnTotals, order)
+	for i := range t.Cells {
+		t.Cells[i] = permute(t.Cells[i], order)
+		t.present[i] = permute(t.present[i], order)
+	}
+	return t
+}
+
+// ToStrings exports the table as a matrix of strings, including a header
+// row, a header column and totals.
+// @group Grouping
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The first row holds an empty corner cell, the column headers and "Total".
+// Each following row holds its row header, its cells and its row total. The
+// last row holds "Total", the column totals and the grand total. Values are
+// formatted with fmt.Sprint. The result is ready for encoding/csv or a text
+// table writer.
+//
+// Example: exporting a table
+//
+//	type Vote struct{ Region, Choice string }
+//
+//	votes := collection.New([]Vote{{"east", "yes"}, {"west", "no"}, {"east", "no"}})
+//	count := func(v []Vote) int { return len(v) }
+//
+//	table := collection.Pivot(votes,
+//		func(v Vote) string { return v.Region },
+//		func(v Vote) string { return v.Choice },
+//		count,
+//	)
+//	fmt.Println(table.ToStrings())
+//	// [[ yes no Total] [east 1 1 2] [west 0 1 1] [Total 1 2 3]]
+func (t *PivotTable[R, C, V]) ToStrings() [][]string {
+	out := make([][]string, 0, len(t.Rows)+2)
+
+	header := make([]string, 0, len(t.Columns)+2)
+	header = append(header, "")
+	for _, col := range t.Columns {
+		header = append(header, fmt.Sprint(col))
+	}
+	header = append(header, "Total")
+	out = append(out, header)
+
+	for i, r := range t.Rows {
+		row := make([]string, 0, len(t.Columns)+2)
+		row = append(row, fmt.Sprint(r))
+		for _, v := range t.Cells[i] {
+			row = append(row, fmt.Sprint(v))
+		}
+		row = append(row, fmt.Sprint(t.RowTotals[i]))
+		out = append(out, row)
+	}
+
+	footer := make([]string, 0, len(t.Columns)+2)
+	footer = append(footer, "Total")
+	for _, v := range t.ColumnTotals {
+		footer = append(footer, fmt.Sprint(v))
+	}
+	footer = append(footer, fmt.Sprint(t.GrandTotal))
+	out = append(out, footer)
+
+	return out
+}
+
+// sortedOrder returns the indexes of keys in the order given by less.
+func sortedOrder[K any](keys []K, less func(a, b K) bool) []int {
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	cmpKeys := lessToCmp(less)
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmpKeys(keys[a], keys[b])
+	})
+	return order
+}
+
+// permute returns a new slice with s[order[i]] at position i.
+func permute[E any](s []E, order []int) []E {
+	out := make([]E, len(s))
+	for i, j := range order {
+		out[i] = s[j]
+	}
+	return out
+}
@@ -0,0 +1,150 @@
+package collection
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type pivotEvent struct {
+	Region string
+	Month  string
+	Errors int
+}
+
+func pivotFixture() *Collection[pivotEvent] {
+	return New([]pivotEvent{
+		{"east", "jan", 3},
+		{"west", "jan", 1},
+		{"east", "feb", 4},
+		{"east", "jan", 2},
+	})
+}
+
+func pivotSum(es []pivotEvent) int {
+	total := 0
+	for _, e := range es {
+		total += e.Errors
+	}
+	return total
+}
+
+func pivotRegion(e pivotEvent) string { return e.Region }
+func pivotMonth(e pivotEvent) string  { return e.Month }
+
+func TestPivot_CellsAndTotals(t *testing.T) {
+	table := Pivot(pivotFixture(), pivotRegion, pivotMonth, pivotSum)
+
+	if !reflect.DeepEqual(table.Rows, []string{"east", "west"}) {
+		t.Fatalf("unexpected rows: %v", table.Rows)
+	}
+	if !reflect.DeepEqual(table.Columns, []string{"jan", "feb"}) {
+		t.Fatalf("unexpected columns: %v", table.Columns)
+	}
+	if !reflect.DeepEqual(table.Cells, [][]int{{5, 4}, {1, 0}}) {
+		t.Fatalf("unexpected cells: %v", table.Cells)
+	}
+	if !reflect.DeepEqual(table.RowTotals, []int{9, 1}) || !reflect.DeepEqual(table.ColumnTotals, []int{6, 4}) {
+		t.Fatalf("unexpected totals: %v %v", table.RowTotals, table.ColumnTotals)
+	}
+	if table.GrandTotal != 10 {
+		t.Fatalf("expected grand total 10, got %d", table.GrandTotal)
+	}
+}
+
+func TestPivot_TotalsUseAggregateOverItems(t *testing.T) {
+	avg := func(es []pivotEvent) float64 {
+		return float64(pivotSum(es)) / float64(len(es))
+	}
+
+	table := Pivot(pivotFixture(), pivotRegion, pivotMonth, avg)
+
+	// east has errors 3, 4, 2: the row total is their mean, not a sum of cell means.
+	if table.RowTotals[0] != 3 {
+		t.Fatalf("expected east average 3, got %v", table.RowTotals[0])
+	}
+	if table.GrandTotal != 2.5 {
+		t.Fatalf("expected overall average 2.5, got %v", table.GrandTotal)
+	}
+}
+
+func TestPivot_AggOnlyCalledWithItems(t *testing.T) {
+	Pivot(pivotFixture(), pivotRegion, pivotMonth, func(es []pivotEvent) int {
+		if len(es) == 0 {
+			t.Fatalf("agg called with an empty group")
+		}
+		return len(es)
+	})
+}
+
+func TestPivotTable_HasAndFill(t *testing.T) {
+	table := Pivot(pivotFixture(), pivotRegion, pivotMonth, pivotSum)
+
+	if !table.Has(1, 0) || table.Has(1, 1) {
+		t.Fatalf("unexpected presence for west row")
+	}
+
+	table.Fill(-1)
+	if table.Cells[1][1] != -1 || table.Cells[0][1] != 4 {
+		t.Fatalf("Fill should only touch missing cells: %v", table.Cells)
+	}
+}
+
+func TestPivotTable_SortRowsAndColumns(t *testing.T) {
+	table := Pivot(pivotFixture(), pivotRegion, pivotMonth, pivotSum).Fill(-1)
+
+	table.SortRows(func(a, b string) bool { return a > b }).
+		SortColumns(func(a, b string) bool { return a < b })
+
+	if !reflect.DeepEqual(table.Rows, []string{"west", "east"}) || !reflect.DeepEqual(table.Columns, []string{"feb", "jan"}) {
+		t.Fatalf("unexpected headers: %v %v", table.Rows, table.Columns)
+	}
+	if !reflect.DeepEqual(table.Cells, [][]int{{-1, 1}, {4, 5}}) {
+		t.Fatalf("cells did not move with headers: %v", table.Cells)
+	}
+	if !reflect.DeepEqual(table.RowTotals, []int{1, 9}) || !reflect.DeepEqual(table.ColumnTotals, []int{4, 6}) {
+		t.Fatalf("totals did not move with headers: %v %v", table.RowTotals, table.ColumnTotals)
+	}
+	if !table.Has(0, 1) || table.Has(0, 0) {
+		t.Fatalf("presence did not move with cells")
+	}
+}
+
+func TestPivotTable_ToStrings(t *testing.T) {
+	table := Pivot(pivotFixture(), pivotRegion, pivotMonth, pivotSum)
+
+	expected := [][]string{
+		{"", "jan", "feb", "Total"},
+		{"east", "5", "4", "9"},
+		{"west", "1", "0", "1"},
+		{"Total", "6", "4", "10"},
+	}
+	if got := table.ToStrings(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestPivotTable_JSON(t *testing.T) {
+	table := Pivot(pivotFixture(), pivotRegion, pivotMonth, pivotSum)
+
+	out, err := json.Marshal(table)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"rows":["east","west"],"columns":["jan","feb"],"cells":[[5,4],[1,0]],"row_totals":[9,1],"column_totals":[6,4],"grand_total":10}`
+	if string(out) != expected {
+		t.Fatalf("expected %s, got %s", expected, out)
+	}
+}
+
+func TestPivot_Empty(t *testing.T) {
+	table := Pivot(New([]pivotEvent{}), pivotRegion, pivotMonth, pivotSum)
+
+	if len(table.Rows) != 0 || len(table.Columns) != 0 || table.GrandTotal != 0 {
+		t.Fatalf("expected empty table, got %+v", table)
+	}
+	if got := table.ToStrings(); !reflect.DeepEqual(got, [][]string{{"", "Total"}, {"Total", "0"}}) {
+		t.Fatalf("unexpected empty export: %v", got)
+	}
+}
@@ -0,0 +1,42 @@
+package collection
+
+// Range returns the difference between the largest and smallest values.
+// Returns (0, false) if the collection is empty.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Like Median and Variance, the result is a float64: the difference is
+// taken after conversion, so narrow integer types such as int8 cannot wrap
+// around. Integers beyond 2^53 in magnitude may lose precision.
+//
+// Example: integers
+//
+//	c := collection.NewNumeric([]int{7, 3, 12, 5})
+//	r, ok := c.Range()
+//	collection.Dump(r, ok)
+//	// 9.000000 #float64
+//	// true #bool
+//
+// Example: narrow integers
+//
+//	r2, _ := collection.NewNumeric([]int8{-128, 127}).Range()
+//	fmt.Println(r2)
+//	// 255
+func (c *NumericCollection[T]) Range() (float64, bool) {
+	if len(c.items) == 0 {
+		return 0, false
+	}
+
+	lo, hi := c.items[0], c.items[0]
+	for _, v := range c.items[1:] {
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	return float64(hi) - float64(lo), true
+}
@@ -0,0 +1,54 @@
+package collection
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRange_Integers(t *testing.T) {
+	r, ok := NewNumeric([]int{7, 3, 12, 5}).Range()
+
+	if !ok || r != 9 {
+		t.Fatalf("expected 9, got %v (ok=%v)", r, ok)
+	}
+}
+
+func TestRange_Unsigned(t *testing.T) {
+	r, ok := NewNumeric([]uint8{200, 10, 255}).Range()
+
+	if !ok || r != 245 {
+		t.Fatalf("expected 245, got %v (ok=%v)", r, ok)
+	}
+}
+
+func TestRange_SignedNarrow(t *testing.T) {
+	r, ok := NewNumeric([]int8{-128, 0, 127}).Range()
+
+	if !ok || r != 255 {
+		t.Fatalf("expected 255, got %v (ok=%v)", r, ok)
+	}
+}
+
+func TestRange_Int64Extremes(t *testing.T) {
+	r, ok := NewNumeric([]int64{math.MinInt64, math.MaxInt64}).Range()
+
+	if !ok || r <= 0 {
+		t.Fatalf("expected a large positive range, got %v (ok=%v)", r, ok)
+	}
+}
+
+func TestRange_Floats(t *testing.T) {
+	r, ok := NewNumeric([]float64{-1.5, 2.25}).Range()
+
+	if !ok || r != 3.75 {
+		t.Fatalf("expected 3.75, got %v (ok=%v)", r, ok)
+	}
+}
+
+func TestRange_Empty(t *testing.T) {
+	r, ok := NewNumeric([]int{}).Range()
+
+	if ok || r != 0 {
+		t.Fatalf("expected (0, false), got (%v, %v)", r, ok)
+	}
+}
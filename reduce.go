@@ -9,6 +9,7 @@ package collection
 //
 // This is useful for computing sums, concatenations, aggregates,
 // or any fold-style reduction.
+// Use ReduceTo when the accumulator needs a different type than T.
 //
 // Example: integers - sum
 //
@@ -0,0 +1,29 @@
+package collection
+
+// ReduceRight collapses the collection into a single value of type R,
+// folding from right to left.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The last item is folded first. For an associative and commutative fn the
+// result matches ReduceTo; otherwise the order matters.
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: strings - reversed concatenation
+//
+//	letters := collection.New([]string{"a", "b", "c"})
+//	out := collection.ReduceRight(letters, "", func(acc string, s string) string {
+//		return acc + s
+//	})
+//	collection.Dump(out)
+//	// "cba" #string
+func ReduceRight[T any, R any](c *Collection[T], initial R, fn func(R, T) R) R {
+	acc := initial
+	for i := len(c.items) - 1; i >= 0; i-- {
+		acc = fn(acc, c.items[i])
+	}
+	return acc
+}
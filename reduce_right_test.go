@@ -0,0 +1,29 @@
+package collection
+
+import "testing"
+
+func TestReduceRight_OrderIsRightToLeft(t *testing.T) {
+	got := ReduceRight(New([]string{"a", "b", "c"}), "", func(acc string, s string) string {
+		return acc + s
+	})
+
+	if got != "cba" {
+		t.Fatalf("expected cba, got %q", got)
+	}
+}
+
+func TestReduceRight_ChangesType(t *testing.T) {
+	got := ReduceRight(New([]int{1, 2, 3}), []int{}, func(acc []int, v int) []int {
+		return append(acc, v*v)
+	})
+
+	if len(got) != 3 || got[0] != 9 || got[2] != 1 {
+		t.Fatalf("expected [9 4 1], got %v", got)
+	}
+}
+
+func TestReduceRight_EmptyReturnsInitial(t *testing.T) {
+	if got := ReduceRight(New([]int{}), 7, func(acc, v int) int { return 0 }); got != 7 {
+		t.Fatalf("expected 7, got %d", got)
+	}
+}
@@ -0,0 +1,79 @@
+package collection
+
+// ReduceTo collapses the collection into a single value of a different type R.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Items are folded from left to right, starting from initial. ReduceTo is
+// the type-changing form of Reduce.
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: building a lookup map
+//
+//	type User struct {
+//		ID   int
+//		Name string
+//	}
+//
+//	users := collection.New([]User{
+//		{ID: 1, Name: "Alice"},
+//		{ID: 2, Name: "Bob"},
+//	})
+//
+//	byID := collection.ReduceTo(users, map[int]string{}, func(acc map[int]string, u User) map[int]string {
+//		acc[u.ID] = u.Name
+//		return acc
+//	})
+//	collection.Dump(byID)
+//	// #map[int]string {
+//	//    1 => "Alice" #string
+//	//    2 => "Bob" #string
+//	// }
+//
+// Example: total string length
+//
+//	words := collection.New([]string{"go", "forj"})
+//	total := collection.ReduceTo(words, 0, func(acc int, w string) int {
+//		return acc + len(w)
+//	})
+//	collection.Dump(total)
+//	// 6 #int
+func ReduceTo[T any, R any](c *Collection[T], initial R, fn func(R, T) R) R {
+	acc := initial
+	for _, v := range c.items {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// ReduceIndexed collapses the collection into a single value of type R,
+// passing each item's index to fn.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Items are folded from left to right, starting from initial.
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: numbered list
+//
+//	steps := collection.New([]string{"build", "test", "ship"})
+//	out := collection.ReduceIndexed(steps, "", func(acc string, i int, s string) string {
+//		return acc + fmt.Sprintf("%d. %s\n", i+1, s)
+//	})
+//	fmt.Print(out)
+//	// 1. build
+//	// 2. test
+//	// 3. ship
+func ReduceIndexed[T any, R any](c *Collection[T], initial R, fn func(acc R, i int, v T) R) R {
+	acc := initial
+	for i, v := range c.items {
+		acc = fn(acc, i, v)
+	}
+	return acc
+}
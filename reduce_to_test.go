@@ -0,0 +1,51 @@
+package collection
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReduceTo_ChangesType(t *testing.T) {
+	words := New([]string{"a", "bb", "ccc"})
+
+	lengths := ReduceTo(words, map[string]int{}, func(acc map[string]int, w string) map[string]int {
+		acc[w] = len(w)
+		return acc
+	})
+
+	if !reflect.DeepEqual(lengths, map[string]int{"a": 1, "bb": 2, "ccc": 3}) {
+		t.Fatalf("unexpected result: %v", lengths)
+	}
+}
+
+func TestReduceTo_OrderIsLeftToRight(t *testing.T) {
+	var b strings.Builder
+
+	ReduceTo(New([]int{1, 2, 3}), &b, func(acc *strings.Builder, v int) *strings.Builder {
+		acc.WriteByte(byte('0' + v))
+		return acc
+	})
+
+	if b.String() != "123" {
+		t.Fatalf("expected 123, got %s", b.String())
+	}
+}
+
+func TestReduceTo_EmptyReturnsInitial(t *testing.T) {
+	got := ReduceTo(New([]int{}), "init", func(acc string, v int) string { return "changed" })
+
+	if got != "init" {
+		t.Fatalf("expected initial value, got %q", got)
+	}
+}
+
+func TestReduceIndexed_PassesIndex(t *testing.T) {
+	got := ReduceIndexed(New([]int{10, 20, 30}), 0, func(acc, i, v int) int {
+		return acc + i*v
+	})
+
+	if got != 0*10+1*20+2*30 {
+		t.Fatalf("expected 80, got %d", got)
+	}
+}
@@ -0,0 +1,47 @@
+package collection
+
+// ReduceWhile collapses the collection into a single value of type R,
+// stopping as soon as fn reports that it is done.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// fn returns the next accumulator and whether to continue. The accumulator
+// returned by the call that stops the fold is kept, and no further items are
+// visited.
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: fill a budget
+//
+//	costs := collection.New([]int{30, 40, 50, 10})
+//	spent := collection.ReduceWhile(costs, 0, func(acc, cost int) (int, bool) {
+//		if acc+cost > 100 {
+//			return acc, false
+//		}
+//		return acc + cost, true
+//	})
+//	collection.Dump(spent)
+//	// 70 #int
+//
+// Example: first N characters across words
+//
+//	words := collection.New([]string{"go", "forj", "collection"})
+//	prefix := collection.ReduceWhile(words, "", func(acc string, w string) (string, bool) {
+//		acc += w
+//		return acc, len(acc) < 5
+//	})
+//	collection.Dump(prefix)
+//	// "goforj" #string
+func ReduceWhile[T any, R any](c *Collection[T], initial R, fn func(R, T) (R, bool)) R {
+	acc := initial
+	for _, v := range c.items {
+		var more bool
+		acc, more = fn(acc, v)
+		if !more {
+			break
+		}
+	}
+	return acc
+}
@@ -0,0 +1,40 @@
+package collection
+
+import "testing"
+
+func TestReduceWhile_StopsEarly(t *testing.T) {
+	visited := 0
+
+	got := ReduceWhile(New([]int{1, 2, 3, 4, 5}), 0, func(acc, v int) (int, bool) {
+		visited++
+		acc += v
+		return acc, acc < 6
+	})
+
+	if got != 6 {
+		t.Fatalf("expected 6, got %d", got)
+	}
+	if visited != 3 {
+		t.Fatalf("expected 3 items visited, got %d", visited)
+	}
+}
+
+func TestReduceWhile_KeepsAccumulatorFromStoppingCall(t *testing.T) {
+	got := ReduceWhile(New([]string{"a", "b", "c"}), "", func(acc string, s string) (string, bool) {
+		return acc + s, s != "b"
+	})
+
+	if got != "ab" {
+		t.Fatalf("expected ab, got %q", got)
+	}
+}
+
+func TestReduceWhile_RunsToEnd(t *testing.T) {
+	got := ReduceWhile(New([]int{1, 2, 3}), 0, func(acc, v int) (int, bool) {
+		return acc + v, true
+	})
+
+	if got != 6 {
+		t.Fatalf("expected 6, got %d", got)
+	}
+}
@@ -0,0 +1,45 @@
+package collection
+
+// RemoveAt removes the item at index i and returns it, along with a boolean
+// indicating whether the index was within bounds.
+// @group Slicing
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// This method mutates the collection in place. Like Filter, later items are
+// shifted down within the existing backing array, so a slice passed to New
+// observes the change. Out-of-range indices, including negative ones, leave
+// the collection unchanged and return the zero value and false.
+//
+// Example: integers
+//
+//	c := collection.New([]int{10, 20, 30})
+//	v, ok := c.RemoveAt(1)
+//	collection.Dump(v, ok, c.Items())
+//	// 20 #int
+//	// true #bool
+//	// #[]int [
+//	//   0 => 10 #int
+//	//   1 => 30 #int
+//	// ]
+//
+// Example: out of range
+//
+//	v2, ok2 := c.RemoveAt(5)
+//	collection.Dump(v2, ok2)
+//	// 0 #int
+//	// false #bool
+func (c *Collection[T]) RemoveAt(i int) (T, bool) {
+	items := c.items
+	if i < 0 || i >= len(items) {
+		var zero T
+		return zero, false
+	}
+
+	v := items[i]
+	copy(items[i:], items[i+1:])
+	clear(items[len(items)-1:])
+	c.items = items[:len(items)-1]
+	return v, true
+}
@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRemoveAt_Positions(t *testing.T) {
+	cases := []struct {
+		i        int
+		value    int
+		expected []int
+	}{
+		{0, 1, []int{2, 3}},
+		{1, 2, []int{1, 3}},
+		{2, 3, []int{1, 2}},
+	}
+
+	for _, tc := range cases {
+		c := New([]int{1, 2, 3})
+		v, ok := c.RemoveAt(tc.i)
+		if !ok || v != tc.value || !reflect.DeepEqual(c.Items(), tc.expected) {
+			t.Fatalf("RemoveAt(%d): got %v, %v, %v", tc.i, v, ok, c.Items())
+		}
+	}
+}
+
+func TestRemoveAt_OutOfRange(t *testing.T) {
+	c := New([]int{1, 2})
+
+	for _, i := range []int{-1, 2, 100} {
+		v, ok := c.RemoveAt(i)
+		if ok || v != 0 {
+			t.Fatalf("RemoveAt(%d): expected zero, false; got %v, %v", i, v, ok)
+		}
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2}) {
+		t.Fatalf("expected collection unchanged, got %v", c.Items())
+	}
+
+	if _, ok := New([]int(nil)).RemoveAt(0); ok {
+		t.Fatalf("expected false for nil collection")
+	}
+}
+
+func TestRemoveAt_WritesThroughSourceSlice(t *testing.T) {
+	a, b, cval := 1, 2, 3
+	items := []*int{&a, &b, &cval}
+	c := New(items)
+
+	c.RemoveAt(0)
+
+	if items[0] != &b || items[1] != &cval {
+		t.Fatalf("expected later items shifted down in the source slice")
+	}
+	if items[2] != nil {
+		t.Fatalf("expected vacated tail to be cleared")
+	}
+	if len(c.Items()) != 2 {
+		t.Fatalf("expected length 2, got %d", len(c.Items()))
+	}
+}
@@ -0,0 +1,60 @@
+package collection
+
+// RemoveWhere removes every item for which pred returns true.
+// It is the inverse of Filter.
+// @group Slicing
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// This method mutates the collection in place and returns the same instance.
+// Like Filter, kept items are compacted within the existing backing array,
+// so a slice passed to New observes the change.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 3, 4, 5})
+//	c.RemoveWhere(func(v int) bool {
+//		return v%2 == 0
+//	})
+//	collection.Dump(c.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 3 #int
+//	//   2 => 5 #int
+//	// ]
+//
+// Example: structs
+//
+//	type Job struct {
+//		ID   int
+//		Done bool
+//	}
+//
+//	jobs := collection.New([]Job{
+//		{ID: 1, Done: true},
+//		{ID: 2, Done: false},
+//	})
+//
+//	jobs.RemoveWhere(func(j Job) bool { return j.Done })
+//	collection.Dump(jobs.Items())
+//	// #[]main.Job [
+//	//   0 => #main.Job {
+//	//     +ID   => 2 #int
+//	//     +Done => false #bool
+//	//   }
+//	// ]
+func (c *Collection[T]) RemoveWhere(pred func(T) bool) *Collection[T] {
+	items := c.items
+	j := 0
+	for _, v := range items {
+		if !pred(v) {
+			items[j] = v
+			j++
+		}
+	}
+
+	clear(items[j:])
+	c.items = items[:j]
+	return c
+}
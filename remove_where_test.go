@@ -0,0 +1,68 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRemoveWhere_Ints(t *testing.T) {
+	c := New([]int{1, 2, 3, 4, 5})
+
+	out := c.RemoveWhere(func(v int) bool { return v > 3 })
+
+	if out != c {
+		t.Fatalf("expected RemoveWhere to return same collection instance")
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3}) {
+		t.Fatalf("unexpected items: %v", c.Items())
+	}
+}
+
+func TestRemoveWhere_NoneAndAll(t *testing.T) {
+	c := New([]int{1, 2})
+	c.RemoveWhere(func(int) bool { return false })
+	if !reflect.DeepEqual(c.Items(), []int{1, 2}) {
+		t.Fatalf("expected no change, got %v", c.Items())
+	}
+
+	c.RemoveWhere(func(int) bool { return true })
+	if len(c.Items()) != 0 {
+		t.Fatalf("expected empty, got %v", c.Items())
+	}
+}
+
+func TestRemoveWhere_InverseOfFilter(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	a := New([]int{1, 2, 3, 4, 5, 6}).RemoveWhere(even).Items()
+	b := New([]int{1, 2, 3, 4, 5, 6}).Filter(func(v int) bool { return !even(v) }).Items()
+
+	if !reflect.DeepEqual(a, b) {
+		t.Fatalf("expected %v, got %v", b, a)
+	}
+}
+
+func TestRemoveWhere_PreservesNilSlice(t *testing.T) {
+	c := New([]int(nil))
+
+	c.RemoveWhere(func(int) bool { return true })
+
+	if c.Items() != nil {
+		t.Fatalf("expected nil slice to remain nil, got %v", c.Items())
+	}
+}
+
+func TestRemoveWhere_WritesThroughAndClearsTail(t *testing.T) {
+	a, b, cval := 1, 2, 3
+	items := []*int{&a, &b, &cval}
+	c := New(items)
+
+	c.RemoveWhere(func(v *int) bool { return *v != 2 })
+
+	if items[0] != &b {
+		t.Fatalf("expected kept value to be compacted to front")
+	}
+	if items[1] != nil || items[2] != nil {
+		t.Fatalf("expected removed tail to be cleared, got %v", items)
+	}
+}
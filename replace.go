@@ -0,0 +1,41 @@
+package collection
+
+// Replace overwrites the items at the given indices with new values.
+// @group Transformation
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// This method mutates the collection in place and returns the same instance.
+// Items are written into the existing backing array, so a slice passed to
+// New observes the change. Indices outside the collection, including
+// negative ones, are ignored; Replace never changes the length.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 3})
+//	c.Replace(map[int]int{0: 10, 2: 30, 9: 90})
+//	collection.Dump(c.Items())
+//	// #[]int [
+//	//   0 => 10 #int
+//	//   1 => 2 #int
+//	//   2 => 30 #int
+//	// ]
+//
+// Example: strings
+//
+//	names := collection.New([]string{"ann", "bob"})
+//	names.Replace(map[int]string{1: "bea"})
+//	collection.Dump(names.Items())
+//	// #[]string [
+//	//   0 => "ann" #string
+//	//   1 => "bea" #string
+//	// ]
+func (c *Collection[T]) Replace(values map[int]T) *Collection[T] {
+	for i, v := range values {
+		if i >= 0 && i < len(c.items) {
+			c.items[i] = v
+		}
+	}
+	return c
+}
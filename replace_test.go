@@ -0,0 +1,51 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReplace_Basic(t *testing.T) {
+	c := New([]string{"a", "b", "c"})
+
+	out := c.Replace(map[int]string{0: "x", 2: "z"})
+
+	if out != c {
+		t.Fatalf("expected Replace to return same collection instance")
+	}
+	if !reflect.DeepEqual(c.Items(), []string{"x", "b", "z"}) {
+		t.Fatalf("unexpected items: %v", c.Items())
+	}
+}
+
+func TestReplace_IgnoresOutOfRange(t *testing.T) {
+	c := New([]int{1, 2})
+
+	c.Replace(map[int]int{-1: 9, 2: 9, 50: 9})
+
+	if !reflect.DeepEqual(c.Items(), []int{1, 2}) {
+		t.Fatalf("expected no change, got %v", c.Items())
+	}
+}
+
+func TestReplace_EmptyAndNilMap(t *testing.T) {
+	c := New([]int{1})
+
+	c.Replace(nil)
+	c.Replace(map[int]int{})
+
+	if !reflect.DeepEqual(c.Items(), []int{1}) {
+		t.Fatalf("expected no change, got %v", c.Items())
+	}
+}
+
+func TestReplace_WritesThroughSourceSlice(t *testing.T) {
+	items := []int{1, 2, 3}
+	c := New(items)
+
+	c.Replace(map[int]int{1: 20})
+
+	if items[1] != 20 {
+		t.Fatalf("expected source slice to observe the replacement, got %v", items)
+	}
+}
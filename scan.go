@@ -0,0 +1,50 @@
+package collection
+
+// Scan folds the collection from left to right like Reduce, but returns every
+// intermediate accumulator instead of only the final one.
+// @group Transformation
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// The result has one item per input item: the accumulator after folding that
+// item. initial itself is not included, so the last item equals what Reduce
+// would return. An empty collection yields an empty result.
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: running balance
+//
+//	txns := collection.New([]int{100, -30, 50, -20})
+//	balance := collection.Scan(txns, 0, func(acc, amount int) int {
+//		return acc + amount
+//	})
+//	collection.Dump(balance.Items())
+//	// #[]int [
+//	//   0 => 100 #int
+//	//   1 => 70 #int
+//	//   2 => 120 #int
+//	//   3 => 100 #int
+//	// ]
+//
+// Example: accumulating into a different type
+//
+//	words := collection.New([]string{"go", "forj", "collection"})
+//	paths := collection.Scan(words, "", func(acc string, w string) string {
+//		return acc + "/" + w
+//	})
+//	collection.Dump(paths.Items())
+//	// #[]string [
+//	//   0 => "/go" #string
+//	//   1 => "/go/forj" #string
+//	//   2 => "/go/forj/collection" #string
+//	// ]
+func Scan[T any, R any](c *Collection[T], initial R, fn func(R, T) R) *Collection[R] {
+	out := make([]R, len(c.items))
+	acc := initial
+	for i, v := range c.items {
+		acc = fn(acc, v)
+		out[i] = acc
+	}
+	return New(out)
+}
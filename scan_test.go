@@ -0,0 +1,43 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScan_RunningTotals(t *testing.T) {
+	out := Scan(New([]int{1, 2, 3, 4}), 0, func(acc, v int) int { return acc + v })
+
+	if !reflect.DeepEqual(out.Items(), []int{1, 3, 6, 10}) {
+		t.Fatalf("expected [1 3 6 10], got %v", out.Items())
+	}
+}
+
+func TestScan_LastMatchesReduce(t *testing.T) {
+	c := New([]int{5, 3, 8})
+	fn := func(acc, v int) int { return acc*10 + v }
+
+	out := Scan(c, 1, fn)
+
+	if last := out.Items()[len(out.Items())-1]; last != c.Reduce(1, fn) {
+		t.Fatalf("expected last accumulator %d to equal Reduce, got %d", c.Reduce(1, fn), last)
+	}
+}
+
+func TestScan_OrderIsLeftToRight(t *testing.T) {
+	out := Scan(New([]string{"a", "b", "c"}), "", func(acc string, v string) string {
+		return acc + v
+	})
+
+	if !reflect.DeepEqual(out.Items(), []string{"a", "ab", "abc"}) {
+		t.Fatalf("expected [a ab abc], got %v", out.Items())
+	}
+}
+
+func TestScan_Empty(t *testing.T) {
+	out := Scan(New([]int{}), 42, func(acc, v int) int { return acc + v })
+
+	if out.Items() == nil || len(out.Items()) != 0 {
+		t.Fatalf("expected empty non-nil result, got %#v", out.Items())
+	}
+}
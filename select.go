@@ -0,0 +1,138 @@
+package collection
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+)
+
+// ranked pairs an item with its original position so that selection
+// helpers can break ties deterministically in favour of earlier items.
+type ranked[T any] struct {
+	item  T
+	index int
+}
+
+// topK returns the k items that order first under cmp, in ranked order,
+// using a bounded max-heap of size k (O(n log k)). Ties keep input order.
+// items is not modified.
+func topK[T any](items []T, k int, compare func(a, b T) int) []T {
+	if k <= 0 || len(items) == 0 {
+		return []T{}
+	}
+	k = min(k, len(items))
+
+	rank := func(a, b ranked[T]) int {
+		if r := compare(a.item, b.item); r != 0 {
+			return r
+		}
+		return cmp.Compare(a.index, b.index)
+	}
+
+	// h is a max-heap under rank: h[0] is the worst item kept so far.
+	h := make([]ranked[T], 0, k)
+	for i, v := range items {
+		r := ranked[T]{item: v, index: i}
+		if len(h) < k {
+			h = append(h, r)
+			heapUp(h, len(h)-1, rank)
+			continue
+		}
+		if rank(r, h[0]) < 0 {
+			h[0] = r
+			heapDown(h, 0, rank)
+		}
+	}
+
+	slices.SortFunc(h, rank)
+
+	out := make([]T, len(h))
+	for i, r := range h {
+		out[i] = r.item
+	}
+	return out
+}
+
+func heapUp[E any](h []E, i int, rank func(a, b E) int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if rank(h[i], h[parent]) <= 0 {
+			return
+		}
+		h[i], h[parent] = h[parent], h[i]
+		i = parent
+	}
+}
+
+func heapDown[E any](h []E, i int, rank func(a, b E) int) {
+	n := len(h)
+	for {
+		largest := i
+		if l := 2*i + 1; l < n && rank(h[l], h[largest]) > 0 {
+			largest = l
+		}
+		if r := 2*i + 2; r < n && rank(h[r], h[largest]) > 0 {
+			largest = r
+		}
+		if largest == i {
+			return
+		}
+		h[i], h[largest] = h[largest], h[i]
+		i = largest
+	}
+}
+
+// quickselect partially reorders s so that s[n] holds the element that would
+// be at index n if s were sorted by cmp, with every element before it
+// ordering no later and every element after it ordering no earlier.
+//
+// It runs in expected O(n) and falls back to a full sort of the remaining
+// range if partitioning degrades (for example, many equal elements).
+func quickselect[T any](s []T, n int, compare func(a, b T) int) {
+	lo, hi := 0, len(s)-1
+	budget := 2 * bits.Len(uint(len(s)))
+
+	for lo < hi {
+		if budget == 0 {
+			slices.SortFunc(s[lo:hi+1], compare)
+			return
+		}
+		budget--
+
+		p := partition(s, lo, hi, compare)
+		switch {
+		case n == p:
+			return
+		case n < p:
+			hi = p - 1
+		default:
+			lo = p + 1
+		}
+	}
+}
+
+// partition moves a median-of-three pivot into its final position within
+// s[lo:hi+1] and returns that position.
+func partition[T any](s []T, lo, hi int, compare func(a, b T) int) int {
+	mid := lo + (hi-lo)/2
+	if compare(s[mid], s[lo]) < 0 {
+		s[mid], s[lo] = s[lo], s[mid]
+	}
+	if compare(s[hi], s[lo]) < 0 {
+		s[hi], s[lo] = s[lo], s[hi]
+	}
+	if compare(s[mid], s[hi]) < 0 {
+		s[mid], s[hi] = s[hi], s[mid]
+	}
+
+	pivot := s[hi]
+	i := lo
+	for j := lo; j < hi; j++ {
+		if compare(s[j], pivot) < 0 {
+			s[i], s[j] = s[j], s[i]
+			i++
+		}
+	}
+	s[i], s[hi] = s[hi], s[i]
+	return i
+}
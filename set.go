@@ -0,0 +1,488 @@
+package collection
+
+import (
+	"iter"
+	"slices"
+)
+
+// Set is an unordered collection of unique, comparable values backed by a map.
+//
+// Membership checks are O(1), so a Set built once can be reused for many
+// lookups, unlike Contains on a Collection. Set algebra is available both as
+// pure methods (Union, Intersect, Difference, SymmetricDifference), which
+// return a new Set, and as in-place methods with a With suffix, which modify
+// the receiver.
+//
+// Iteration order is unspecified; use Sorted for deterministic output.
+// The zero value is an empty set ready to use. Set is not safe for
+// concurrent use.
+type Set[T comparable] struct {
+	m map[T]struct{}
+}
+
+// NewSet creates a Set containing the given values.
+// @group Set Operations
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Duplicate values are stored once.
+//
+// Example: membership checks
+//
+//	perms := collection.NewSet("read", "write", "read")
+//	fmt.Println(perms.Len(), perms.Has("write"), perms.Has("admin"))
+//	// 2 true false
+func NewSet[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{m: make(map[T]struct{}, len(values))}
+	for _, v := range values {
+		s.m[v] = struct{}{}
+	}
+	return s
+}
+
+// ToSet creates a Set from the items in the collection.
+// @group Set Operations
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// This cannot be a method because methods can't narrow T to comparable.
+//
+// Example: deduplicating tags
+//
+//	tags := collection.New([]string{"go", "db", "go", "api"})
+//	set := collection.ToSet(tags)
+//	collection.Dump(set.Sorted(func(a, b string) bool { return a < b }).Items())
+//	// #[]string [
+//	//   0 => "api" #string
+//	//   1 => "db" #string
+//	//   2 => "go" #string
+//	// ]
+func ToSet[T comparable](c *Collection[T]) *Set[T] {
+	return NewSet(c.items...)
+}
+
+// Len returns the number of values in the set.
+// @group Set Operations
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: counting values
+//
+//	fmt.Println(collection.NewSet(1, 2, 2, 3).Len())
+//	// 3
+func (s *Set[T]) Len() int {
+	return len(s.m)
+}
+
+// Has reports whether v is in the set.
+// @group Set Operations
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	s := collection.NewSet(1, 2, 3)
+//	fmt.Println(s.Has(2), s.Has(9))
+//	// true false
+func (s *Set[T]) Has(v T) bool {
+	_, ok := s.m[v]
+	return ok
+}
+
+// Add inserts values into the set and returns the same set.
+// This method mutates the set in place.
+// @group Set Operations
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: adding values
+//
+//	s := collection.NewSet[int]().Add(3, 1).Add(1, 2)
+//	collection.Dump(s.Sorted(func(a, b int) bool { return a < b }).Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func (s *Set[T]) Add(values ...T) *Set[T] {
+	if s.m == nil {
+		s.m = make(map[T]struct{}, len(values))
+	}
+	for _, v := range values {
+		s.m[v] = struct{}{}
+	}
+	return s
+}
+
+// Remove deletes values from the set and returns the same set.
+// This method mutates the set in place.
+// @group Set Operations
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Values that are not present are ignored.
+//
+// Example: removing values
+//
+//	s := collection.NewSet(1, 2, 3).Remove(2, 9)
+//	collection.Dump(s.Sorted(func(a, b int) bool { return a < b }).Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 3 #int
+//	// ]
+func (s *Set[T]) Remove(values ...T) *Set[T] {
+	for _, v := range values {
+		delete(s.m, v)
+	}
+	return s
+}
+
+// Clone returns a new set with the same values.
+// @group Set Operations
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Example: independent copy
+//
+//	a := collection.NewSet(1, 2)
+//	b := a.Clone().Add(3)
+//	fmt.Println(a.Len(), b.Len())
+//	// 2 3
+func (s *Set[T]) Clone() *Set[T] {
+	out := &Set[T]{m: make(map[T]struct{}, len(s.m))}
+	for v := range s.m {
+		out.m[v] = struct{}{}
+	}
+	return out
+}
+
+// Union returns a new set with the values that are in s or other.
+// @group Set Operations
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	a := collection.NewSet(1, 2)
+//	b := collection.NewSet(2, 3)
+//	collection.Dump(a.Union(b).Sorted(func(x, y int) bool { return x < y }).Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	return s.Clone().UnionWith(other)
+}
+
+// Intersect returns a new set with the values that are in both s and other.
+// @group Set Operations
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	a := collection.NewSet(1, 2, 3)
+//	b := collection.NewSet(2, 3, 4)
+//	collection.Dump(a.Intersect(b).Sorted(func(x, y int) bool { return x < y }).Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 3 #int
+//	// ]
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if other.Len() < s.Len() {
+		small, large = other, s
+	}
+
+	out := &Set[T]{m: make(map[T]struct{}, small.Len())}
+	for v := range small.m {
+		if large.Has(v) {
+			out.m[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Difference returns a new set with the values in s that are not in other.
+// @group Set Operations
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	a := collection.NewSet(1, 2, 3)
+//	b := collection.NewSet(2)
+//	collection.Dump(a.Difference(b).Sorted(func(x, y int) bool { return x < y }).Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 3 #int
+//	// ]
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	out := &Set[T]{m: make(map[T]struct{}, len(s.m))}
+	for v := range s.m {
+		if !other.Has(v) {
+			out.m[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+// SymmetricDifference returns a new set with the values that are in exactly
+// one of s and other.
+// @group Set Operations
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	a := collection.NewSet(1, 2, 3)
+//	b := collection.NewSet(3, 4)
+//	collection.Dump(a.SymmetricDifference(b).Sorted(func(x, y int) bool { return x < y }).Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 4 #int
+//	// ]
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return s.Clone().SymmetricDifferenceWith(other)
+}
+
+// UnionWith adds every value from other to s and returns s.
+// This method mutates the set in place.
+// @group Set Operations
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: merging granted permissions
+//
+//	granted := collection.NewSet("read")
+//	granted.UnionWith(collection.NewSet("write", "read"))
+//	fmt.Println(granted.Len(), granted.Has("write"))
+//	// 2 true
+func (s *Set[T]) UnionWith(other *Set[T]) *Set[T] {
+	if s.m == nil {
+		s.m = make(map[T]struct{}, other.Len())
+	}
+	for v := range other.m {
+		s.m[v] = struct{}{}
+	}
+	return s
+}
+
+// IntersectWith removes every value from s that is not in other and returns s.
+// This method mutates the set in place.
+// @group Set Operations
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	s := collection.NewSet(1, 2, 3)
+//	s.IntersectWith(collection.NewSet(2, 3, 4))
+//	fmt.Println(s.Len(), s.Has(1))
+//	// 2 false
+func (s *Set[T]) IntersectWith(other *Set[T]) *Set[T] {
+	for v := range s.m {
+		if !other.Has(v) {
+			delete(s.m, v)
+		}
+	}
+	return s
+}
+
+// DifferenceWith removes every value in other from s and returns s.
+// This method mutates the set in place.
+// @group Set Operations
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: revoking permissions
+//
+//	perms := collection.NewSet("read", "write", "delete")
+//	perms.DifferenceWith(collection.NewSet("delete"))
+//	fmt.Println(perms.Len(), perms.Has("delete"))
+//	// 2 false
+func (s *Set[T]) DifferenceWith(other *Set[T]) *Set[T] {
+	for v := range other.m {
+		delete(s.m, v)
+	}
+	return s
+}
+
+// SymmetricDifferenceWith updates s to hold the values that are in exactly
+// one of s and other, and returns s.
+// This method mutates the set in place.
+// @group Set Operations
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: toggling flags
+//
+//	flags := collection.NewSet("a", "b")
+//	flags.SymmetricDifferenceWith(collection.NewSet("b", "c"))
+//	collection.Dump(flags.Sorted(func(x, y string) bool { return x < y }).Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "c" #string
+//	// ]
+func (s *Set[T]) SymmetricDifferenceWith(other *Set[T]) *Set[T] {
+	if s == other {
+		clear(s.m)
+		return s
+	}
+	if s.m == nil {
+		s.m = make(map[T]struct{}, other.Len())
+	}
+	for v := range other.m {
+		if _, ok := s.m[v]; ok {
+			delete(s.m, v)
+		} else {
+			s.m[v] = struct{}{}
+		}
+	}
+	return s
+}
+
+// IsSubset reports whether every value in s is also in other.
+// @group Set Operations
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: required permissions
+//
+//	required := collection.NewSet("read", "write")
+//	granted := collection.NewSet("read", "write", "admin")
+//	fmt.Println(required.IsSubset(granted))
+//	// true
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for v := range s.m {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether s contains every value in other.
+// @group Set Operations
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	fmt.Println(collection.NewSet(1, 2, 3).IsSuperset(collection.NewSet(1, 3)))
+//	// true
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint reports whether s and other have no values in common.
+// @group Set Operations
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	a := collection.NewSet(1, 2)
+//	fmt.Println(a.IsDisjoint(collection.NewSet(3, 4)), a.IsDisjoint(collection.NewSet(2)))
+//	// true false
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	small, large := s, other
+	if other.Len() < s.Len() {
+		small, large = other, s
+	}
+	for v := range small.m {
+		if large.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Values returns an iterator over the values in the set, in unspecified order.
+// @group Set Operations
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: summing values
+//
+//	total := 0
+//	for v := range collection.NewSet(1, 2, 3).Values() {
+//		total += v
+//	}
+//	fmt.Println(total)
+//	// 6
+func (s *Set[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToCollection returns the values as a new collection, in unspecified order.
+// @group Set Operations
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Use Sorted when the order must be deterministic.
+//
+// Example: back to a collection
+//
+//	c := collection.NewSet(1, 2, 3).ToCollection()
+//	fmt.Println(c.Count())
+//	// 3
+func (s *Set[T]) ToCollection() *Collection[T] {
+	out := make([]T, 0, len(s.m))
+	for v := range s.m {
+		out = append(out, v)
+	}
+	return New(out)
+}
+
+// Sorted returns the values as a new collection ordered by less.
+// @group Set Operations
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Example: deterministic export
+//
+//	s := collection.NewSet("b", "c", "a")
+//	collection.Dump(s.Sorted(func(x, y string) bool { return x < y }).Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	//   2 => "c" #string
+//	// ]
+func (s *Set[T]) Sorted(less func(a, b T) bool) *Collection[T] {
+	c := s.ToCollection()
+	slices.SortFunc(c.items, lessToCmp(less))
+	return c
+}
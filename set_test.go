@@ -0,0 +1,158 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func sortedInts(s *Set[int]) []int {
+	return s.Sorted(func(a, b int) bool { return a < b }).Items()
+}
+
+func TestNewSet_Dedupes(t *testing.T) {
+	s := NewSet(3, 1, 3, 2)
+
+	if s.Len() != 3 {
+		t.Fatalf("expected 3 values, got %d", s.Len())
+	}
+	if !reflect.DeepEqual(sortedInts(s), []int{1, 2, 3}) {
+		t.Fatalf("unexpected values: %v", sortedInts(s))
+	}
+}
+
+func TestSet_ZeroValueUsable(t *testing.T) {
+	var s Set[string]
+
+	if s.Has("a") || s.Len() != 0 {
+		t.Fatalf("expected empty zero value")
+	}
+	s.Remove("a")
+	s.Add("a")
+	if !s.Has("a") {
+		t.Fatalf("expected a after Add")
+	}
+
+	var u Set[int]
+	u.UnionWith(NewSet(1))
+	if !u.Has(1) {
+		t.Fatalf("UnionWith on zero value should add values")
+	}
+}
+
+func TestToSet_FromCollection(t *testing.T) {
+	c := New([]int{1, 2, 2, 3})
+
+	s := ToSet(c)
+
+	if !reflect.DeepEqual(sortedInts(s), []int{1, 2, 3}) {
+		t.Fatalf("unexpected values: %v", sortedInts(s))
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 2, 3}) {
+		t.Fatalf("source collection was modified")
+	}
+}
+
+func TestSet_PureOpsDoNotMutate(t *testing.T) {
+	a := NewSet(1, 2, 3)
+	b := NewSet(3, 4)
+
+	cases := []struct {
+		name string
+		got  *Set[int]
+		want []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4}},
+		{"intersect", a.Intersect(b), []int{3}},
+		{"difference", a.Difference(b), []int{1, 2}},
+		{"symmetric", a.SymmetricDifference(b), []int{1, 2, 4}},
+	}
+	for _, tc := range cases {
+		if !reflect.DeepEqual(sortedInts(tc.got), tc.want) {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, sortedInts(tc.got))
+		}
+	}
+
+	if !reflect.DeepEqual(sortedInts(a), []int{1, 2, 3}) || !reflect.DeepEqual(sortedInts(b), []int{3, 4}) {
+		t.Fatalf("operands were mutated: %v %v", sortedInts(a), sortedInts(b))
+	}
+}
+
+func TestSet_InPlaceOps(t *testing.T) {
+	b := NewSet(3, 4)
+
+	cases := []struct {
+		name string
+		op   func(s *Set[int]) *Set[int]
+		want []int
+	}{
+		{"union", func(s *Set[int]) *Set[int] { return s.UnionWith(b) }, []int{1, 2, 3, 4}},
+		{"intersect", func(s *Set[int]) *Set[int] { return s.IntersectWith(b) }, []int{3}},
+		{"difference", func(s *Set[int]) *Set[int] { return s.DifferenceWith(b) }, []int{1, 2}},
+		{"symmetric", func(s *Set[int]) *Set[int] { return s.SymmetricDifferenceWith(b) }, []int{1, 2, 4}},
+	}
+	for _, tc := range cases {
+		s := NewSet(1, 2, 3)
+		if out := tc.op(s); out != s {
+			t.Fatalf("%s: expected the same set to be returned", tc.name)
+		}
+		if !reflect.DeepEqual(sortedInts(s), tc.want) {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, sortedInts(s))
+		}
+	}
+}
+
+func TestSet_SymmetricDifferenceWithSelf(t *testing.T) {
+	s := NewSet(1, 2)
+
+	s.SymmetricDifferenceWith(s)
+
+	if s.Len() != 0 {
+		t.Fatalf("expected empty set, got %v", sortedInts(s))
+	}
+}
+
+func TestSet_Relations(t *testing.T) {
+	a := NewSet(1, 2)
+	b := NewSet(1, 2, 3)
+	c := NewSet(4)
+	empty := NewSet[int]()
+
+	if !a.IsSubset(b) || b.IsSubset(a) {
+		t.Fatalf("unexpected IsSubset result")
+	}
+	if !b.IsSuperset(a) || a.IsSuperset(b) {
+		t.Fatalf("unexpected IsSuperset result")
+	}
+	if !a.IsDisjoint(c) || a.IsDisjoint(b) {
+		t.Fatalf("unexpected IsDisjoint result")
+	}
+	if !empty.IsSubset(a) || !empty.IsDisjoint(a) || !a.IsSubset(a) {
+		t.Fatalf("unexpected result for empty or identical sets")
+	}
+}
+
+func TestSet_CloneIsIndependent(t *testing.T) {
+	a := NewSet(1)
+	b := a.Clone().Add(2)
+
+	if a.Has(2) || !b.Has(1) {
+		t.Fatalf("clone should copy values without sharing storage")
+	}
+}
+
+func TestSet_ToCollectionAndValues(t *testing.T) {
+	s := NewSet("x", "y")
+
+	if got := s.ToCollection().Count(); got != 2 {
+		t.Fatalf("expected 2 items, got %d", got)
+	}
+
+	n := 0
+	for range s.Values() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Fatalf("expected iteration to stop after break")
+	}
+}
@@ -1,6 +1,6 @@
 package collection
 
-import "sort"
+import "slices"
 
 // Sort sorts the collection in place using the provided comparison function and
 // returns the same collection for chaining.
@@ -13,6 +13,8 @@ import "sort"
 // before `b` in the sorted order.
 //
 // This operation mutates the underlying slice (no allocation).
+// The sort is not guaranteed to be stable; use SortStable when items that
+// compare equal must keep their original order.
 //
 // Example: integers
 //
@@ -70,8 +72,100 @@ import "sort"
 //	//   }
 //	// ]
 func (c *Collection[T]) Sort(less func(a, b T) bool) *Collection[T] {
-	sort.Slice(c.items, func(i, j int) bool {
-		return less(c.items[i], c.items[j])
-	})
+	slices.SortFunc(c.items, lessToCmp(less))
 	return c
 }
+
+// SortStable sorts the collection in place using the provided comparison
+// function, keeping items that compare equal in their original order, and
+// returns the same collection for chaining.
+// @group Ordering
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// The comparison function `less(a, b)` should return true if `a` should come
+// before `b` in the sorted order.
+//
+// This operation mutates the underlying slice (no allocation).
+//
+// Example: structs - ties keep insertion order
+//
+//	type Row struct {
+//		ID    int
+//		Score int
+//	}
+//
+//	rows := collection.New([]Row{
+//		{ID: 1, Score: 20},
+//		{ID: 2, Score: 10},
+//		{ID: 3, Score: 20},
+//		{ID: 4, Score: 10},
+//	})
+//
+//	rows.SortStable(func(a, b Row) bool { return a.Score < b.Score })
+//	collection.Dump(rows.Items())
+//	// #[]main.Row [
+//	//   0 => #main.Row {
+//	//     +ID    => 2 #int
+//	//     +Score => 10 #int
+//	//   }
+//	//   1 => #main.Row {
+//	//     +ID    => 4 #int
+//	//     +Score => 10 #int
+//	//   }
+//	//   2 => #main.Row {
+//	//     +ID    => 1 #int
+//	//     +Score => 20 #int
+//	//   }
+//	//   3 => #main.Row {
+//	//     +ID    => 3 #int
+//	//     +Score => 20 #int
+//	//   }
+//	// ]
+func (c *Collection[T]) SortStable(less func(a, b T) bool) *Collection[T] {
+	slices.SortStableFunc(c.items, lessToCmp(less))
+	return c
+}
+
+// IsSorted reports whether the collection is sorted according to less.
+// @group Ordering
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Empty and single-item collections are always sorted.
+//
+// Example: integers
+//
+//	c := collection.New([]int{1, 2, 2, 5})
+//	collection.Dump(c.IsSorted(func(a, b int) bool { return a < b }))
+//	// true #bool
+//
+// Example: strings - descending check
+//
+//	c2 := collection.New([]string{"a", "c", "b"})
+//	collection.Dump(c2.IsSorted(func(a, b string) bool { return a > b }))
+//	// false #bool
+func (c *Collection[T]) IsSorted(less func(a, b T) bool) bool {
+	for i := 1; i < len(c.items); i++ {
+		if less(c.items[i], c.items[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// lessToCmp adapts a less function to the three-way form used by the
+// slices package.
+func lessToCmp[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	}
+}
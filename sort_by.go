@@ -0,0 +1,100 @@
+package collection
+
+import "slices"
+
+// SortBy sorts the collection in place by the key returned from keyFn,
+// ascending, and returns the same collection.
+// @group Ordering
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// The sort is stable: items with equal keys keep their original order.
+// keyFn is called O(n log n) times; precompute expensive keys if needed.
+//
+// This cannot be a method because methods can't introduce a new type parameter K.
+//
+// Example: structs by age
+//
+//	type User struct {
+//		Name string
+//		Age  int
+//	}
+//
+//	users := collection.New([]User{
+//		{Name: "Alice", Age: 30},
+//		{Name: "Bob", Age: 25},
+//		{Name: "Carol", Age: 30},
+//	})
+//
+//	collection.SortBy(users, func(u User) int { return u.Age })
+//	collection.Dump(users.Items())
+//	// #[]main.User [
+//	//   0 => #main.User {
+//	//     +Name => "Bob" #string
+//	//     +Age  => 25 #int
+//	//   }
+//	//   1 => #main.User {
+//	//     +Name => "Alice" #string
+//	//     +Age  => 30 #int
+//	//   }
+//	//   2 => #main.User {
+//	//     +Name => "Carol" #string
+//	//     +Age  => 30 #int
+//	//   }
+//	// ]
+func SortBy[T any, K Number | ~string](c *Collection[T], keyFn func(T) K) *Collection[T] {
+	return c.SortWith(By(keyFn).Compare)
+}
+
+// SortByDesc sorts the collection in place by the key returned from keyFn,
+// descending, and returns the same collection.
+// @group Ordering
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// The sort is stable: items with equal keys keep their original order.
+//
+// This cannot be a method because methods can't introduce a new type parameter K.
+//
+// Example: strings by length
+//
+//	words := collection.New([]string{"go", "forj", "collection", "zig"})
+//	collection.SortByDesc(words, func(s string) int { return len(s) })
+//	collection.Dump(words.Items())
+//	// #[]string [
+//	//   0 => "collection" #string
+//	//   1 => "forj" #string
+//	//   2 => "zig" #string
+//	//   3 => "go" #string
+//	// ]
+func SortByDesc[T any, K Number | ~string](c *Collection[T], keyFn func(T) K) *Collection[T] {
+	return c.SortWith(ByDesc(keyFn).Compare)
+}
+
+// SortWith sorts the collection in place using a three-way comparison
+// function and returns the same collection.
+// @group Ordering
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// cmp returns a negative number when a sorts before b, zero when they are
+// equal and a positive number otherwise. It accepts Comparator.Compare as
+// well as cmp.Compare-style functions. The sort is stable.
+//
+// Example: integers with cmp.Compare
+//
+//	c := collection.New([]int{3, 1, 2})
+//	c.SortWith(cmp.Compare[int])
+//	collection.Dump(c.Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func (c *Collection[T]) SortWith(cmp func(a, b T) int) *Collection[T] {
+	slices.SortStableFunc(c.items, cmp)
+	return c
+}
@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+)
+
+func TestSortBy_StableAscending(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+
+	c := New([]user{
+		{"a", 30},
+		{"b", 20},
+		{"c", 30},
+		{"d", 20},
+	})
+
+	out := SortBy(c, func(u user) int { return u.age })
+
+	expected := []user{{"b", 20}, {"d", 20}, {"a", 30}, {"c", 30}}
+	if !reflect.DeepEqual(out.Items(), expected) {
+		t.Fatalf("expected %v, got %v", expected, out.Items())
+	}
+	if out != c {
+		t.Fatalf("SortBy should return the same collection")
+	}
+}
+
+func TestSortByDesc_StableDescending(t *testing.T) {
+	c := New([]string{"aa", "b", "cc", "d"})
+
+	SortByDesc(c, func(s string) int { return len(s) })
+
+	expected := []string{"aa", "cc", "b", "d"}
+	if !reflect.DeepEqual(c.Items(), expected) {
+		t.Fatalf("expected %v, got %v", expected, c.Items())
+	}
+}
+
+func TestSortBy_StringKeys(t *testing.T) {
+	c := New([]string{"pear", "apple", "fig"})
+
+	SortBy(c, func(s string) string { return s })
+
+	if !reflect.DeepEqual(c.Items(), []string{"apple", "fig", "pear"}) {
+		t.Fatalf("unexpected order: %v", c.Items())
+	}
+}
+
+func TestSortWith_MutatesInPlace(t *testing.T) {
+	items := []int{3, 1, 2}
+
+	New(items).SortWith(cmp.Compare[int])
+
+	if !reflect.DeepEqual(items, []int{1, 2, 3}) {
+		t.Fatalf("expected source slice sorted, got %v", items)
+	}
+}
@@ -62,20 +62,20 @@ func TestSort_StructsByField(t *testing.T) {
 	}
 }
 
-func TestSort_StableWhenEqual(t *testing.T) {
+func TestSortStable_StableWhenEqual(t *testing.T) {
 	type Item struct {
 		ID    int
 		Value int
 	}
 
-	// Value ties: 1, 1, 1 — original order must be preserved (Go sort.Slice is stable)
+	// Value ties: 1, 1, 1 — original order must be preserved (Sort makes no such promise)
 	c := New([]Item{
 		{1, 10},
 		{2, 10},
 		{3, 10},
 	})
 
-	sorted := c.Sort(func(a, b Item) bool {
+	sorted := c.SortStable(func(a, b Item) bool {
 		return a.Value < b.Value
 	})
 
@@ -90,6 +90,24 @@ func TestSort_StableWhenEqual(t *testing.T) {
 	}
 }
 
+func TestSort_OrdersItemsWithTies(t *testing.T) {
+	type Item struct {
+		ID    int
+		Value int
+	}
+
+	c := New([]Item{{1, 20}, {2, 10}, {3, 20}, {4, 10}})
+
+	c.Sort(func(a, b Item) bool { return a.Value < b.Value })
+
+	// Only the ordering is asserted; ties may come back in any order.
+	for i := 1; i < len(c.items); i++ {
+		if c.items[i-1].Value > c.items[i].Value {
+			t.Fatalf("expected items ordered by Value, got %v", c.items)
+		}
+	}
+}
+
 func TestSort_EmptyCollection(t *testing.T) {
 	c := New([]int{})
 
@@ -143,3 +161,72 @@ func TestSort_LengthUnchanged(t *testing.T) {
 		t.Fatalf("expected length 3, got %d", len(c.Items()))
 	}
 }
+
+func TestSortStable_PreservesOrderOfEqualItemsAtScale(t *testing.T) {
+	type Item struct {
+		ID  int
+		Key int
+	}
+
+	items := make([]Item, 500)
+	for i := range items {
+		items[i] = Item{ID: i, Key: (i * 7) % 5}
+	}
+
+	New(items).SortStable(func(a, b Item) bool { return a.Key < b.Key })
+
+	for i := 1; i < len(items); i++ {
+		prev, cur := items[i-1], items[i]
+		if prev.Key > cur.Key {
+			t.Fatalf("not sorted at %d: %v before %v", i, prev, cur)
+		}
+		if prev.Key == cur.Key && prev.ID > cur.ID {
+			t.Fatalf("equal keys reordered at %d: %v before %v", i, prev, cur)
+		}
+	}
+}
+
+func TestSortStable_ReturnsSameCollection(t *testing.T) {
+	c := New([]int{2, 1})
+
+	if out := c.SortStable(func(a, b int) bool { return a < b }); out != c {
+		t.Fatalf("SortStable should return the same collection")
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", c.Items())
+	}
+}
+
+func TestSort_LargeInputIsSorted(t *testing.T) {
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = (i * 7919) % 1000
+	}
+
+	c := New(items).Sort(func(a, b int) bool { return a < b })
+
+	if !c.IsSorted(func(a, b int) bool { return a < b }) {
+		t.Fatalf("expected collection to be sorted")
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	cases := []struct {
+		items []int
+		want  bool
+	}{
+		{nil, true},
+		{[]int{1}, true},
+		{[]int{1, 1, 2}, true},
+		{[]int{2, 1}, false},
+		{[]int{1, 3, 2}, false},
+	}
+
+	for _, tc := range cases {
+		if got := New(tc.items).IsSorted(less); got != tc.want {
+			t.Fatalf("IsSorted(%v) = %v, want %v", tc.items, got, tc.want)
+		}
+	}
+}
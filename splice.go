@@ -0,0 +1,62 @@
+package collection
+
+// Splice removes deleteCount items starting at start, inserts the given
+// values in their place, and returns the removed items.
+// @group Transformation
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// This method mutates the collection in place. Like Prepend, the result is
+// built in a new backing slice, so the slice originally passed to New is
+// left untouched. The returned items are a view of the old backing array,
+// which Splice never writes to.
+//
+// start is clamped to the collection bounds and deleteCount to the number of
+// items after start, so Splice never panics. With deleteCount <= 0 it only
+// inserts; with no values it only removes.
+//
+// Example: integers - replace a range
+//
+//	c := collection.New([]int{1, 2, 3, 4, 5})
+//	removed := c.Splice(1, 2, 20, 30, 40)
+//	collection.Dump(removed, c.Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 3 #int
+//	// ]
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 20 #int
+//	//   2 => 30 #int
+//	//   3 => 40 #int
+//	//   4 => 4 #int
+//	//   5 => 5 #int
+//	// ]
+//
+// Example: strings - remove the tail
+//
+//	letters := collection.New([]string{"a", "b", "c", "d"})
+//	tail := letters.Splice(2, 10)
+//	collection.Dump(tail, letters.Items())
+//	// #[]string [
+//	//   0 => "c" #string
+//	//   1 => "d" #string
+//	// ]
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	// ]
+func (c *Collection[T]) Splice(start, deleteCount int, values ...T) []T {
+	items := c.items
+	start = min(max(start, 0), len(items))
+	end := start + min(max(deleteCount, 0), len(items)-start)
+
+	out := make([]T, 0, len(items)-(end-start)+len(values))
+	out = append(out, items[:start]...)
+	out = append(out, values...)
+	out = append(out, items[end:]...)
+	c.items = out
+
+	return items[start:end]
+}
@@ -0,0 +1,65 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplice_Cases(t *testing.T) {
+	cases := []struct {
+		name       string
+		start, del int
+		values     []int
+		removed    []int
+		expected   []int
+	}{
+		{"replace middle", 1, 2, []int{8, 9}, []int{2, 3}, []int{1, 8, 9, 4}},
+		{"insert only", 2, 0, []int{7}, []int{}, []int{1, 2, 7, 3, 4}},
+		{"negative delete inserts only", 2, -5, []int{7}, []int{}, []int{1, 2, 7, 3, 4}},
+		{"remove only", 0, 1, nil, []int{1}, []int{2, 3, 4}},
+		{"delete past end", 3, 10, nil, []int{4}, []int{1, 2, 3}},
+		{"start past end appends", 10, 2, []int{5}, []int{}, []int{1, 2, 3, 4, 5}},
+		{"negative start clamps", -2, 1, []int{0}, []int{1}, []int{0, 2, 3, 4}},
+		{"remove everything", 0, 4, nil, []int{1, 2, 3, 4}, []int{}},
+	}
+
+	for _, tc := range cases {
+		c := New([]int{1, 2, 3, 4})
+		removed := c.Splice(tc.start, tc.del, tc.values...)
+
+		if len(removed) != len(tc.removed) || (len(removed) > 0 && !reflect.DeepEqual(removed, tc.removed)) {
+			t.Fatalf("%s: expected removed %v, got %v", tc.name, tc.removed, removed)
+		}
+		if !reflect.DeepEqual(c.Items(), tc.expected) {
+			t.Fatalf("%s: expected items %v, got %v", tc.name, tc.expected, c.Items())
+		}
+	}
+}
+
+func TestSplice_DoesNotMutateSourceSlice(t *testing.T) {
+	orig := []int{1, 2, 3, 4}
+	c := New(orig)
+
+	removed := c.Splice(1, 2, 20, 30, 40)
+
+	if !reflect.DeepEqual(orig, []int{1, 2, 3, 4}) {
+		t.Fatalf("Splice mutated source slice: %v", orig)
+	}
+
+	// Removed items stay valid after further edits to the collection.
+	c.Replace(map[int]int{1: 0, 2: 0})
+	c.InsertAt(0, -1)
+	if !reflect.DeepEqual(removed, []int{2, 3}) {
+		t.Fatalf("removed items changed after later edits: %v", removed)
+	}
+}
+
+func TestSplice_NilSlice(t *testing.T) {
+	c := New([]int(nil))
+
+	removed := c.Splice(0, 3, 1)
+
+	if len(removed) != 0 || !reflect.DeepEqual(c.Items(), []int{1}) {
+		t.Fatalf("unexpected result: %v, %v", removed, c.Items())
+	}
+}
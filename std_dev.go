@@ -0,0 +1,45 @@
+package collection
+
+import "math"
+
+// StdDev returns the population standard deviation of the collection.
+// Returns (0, false) if the collection is empty.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// StdDev is the square root of Variance.
+//
+// Example: integers
+//
+//	c := collection.NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	sd, ok := c.StdDev()
+//	collection.Dump(sd, ok)
+//	// 2.000000 #float64
+//	// true #bool
+func (c *NumericCollection[T]) StdDev() (float64, bool) {
+	v, ok := c.Variance()
+	return math.Sqrt(v), ok
+}
+
+// SampleStdDev returns the sample standard deviation of the collection.
+// Returns (0, false) if the collection has fewer than two items.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// SampleStdDev is the square root of SampleVariance.
+//
+// Example: latencies in milliseconds
+//
+//	c := collection.NewNumeric([]float64{12, 15, 11, 18})
+//	sd, ok := c.SampleStdDev()
+//	collection.Dump(sd, ok)
+//	// 3.162278 #float64
+//	// true #bool
+func (c *NumericCollection[T]) SampleStdDev() (float64, bool) {
+	v, ok := c.SampleVariance()
+	return math.Sqrt(v), ok
+}
@@ -0,0 +1,34 @@
+package collection
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStdDev_Population(t *testing.T) {
+	sd, ok := NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9}).StdDev()
+
+	if !ok || sd != 2 {
+		t.Fatalf("expected 2, got %v (ok=%v)", sd, ok)
+	}
+}
+
+func TestSampleStdDev(t *testing.T) {
+	sd, ok := NewNumeric([]float64{12, 15, 11, 18}).SampleStdDev()
+
+	if !ok || math.Abs(sd-math.Sqrt(10)) > 1e-12 {
+		t.Fatalf("expected sqrt(10), got %v (ok=%v)", sd, ok)
+	}
+}
+
+func TestStdDev_Empty(t *testing.T) {
+	sd, ok := NewNumeric([]int{}).StdDev()
+	if ok || sd != 0 {
+		t.Fatalf("expected (0, false), got (%v, %v)", sd, ok)
+	}
+
+	sd, ok = NewNumeric([]int{1}).SampleStdDev()
+	if ok || sd != 0 {
+		t.Fatalf("expected (0, false) for one item, got (%v, %v)", sd, ok)
+	}
+}
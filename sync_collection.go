@@ -0,0 +1,515 @@
+package collection
+
+import "sync"
+
+// SyncCollection is a Collection guarded by a sync.RWMutex, safe for use by
+// multiple goroutines.
+//
+// Read-only operations take a read lock and mutating operations take a write
+// lock, so each call is atomic on its own. Chained calls are not: use Update
+// or View to run several operations as one transaction.
+//
+// The zero value is an empty collection ready to use. A SyncCollection must
+// not be copied after first use.
+type SyncCollection[T any] struct {
+	mu sync.RWMutex
+	c  Collection[T]
+}
+
+// NewSync creates a SyncCollection holding a copy of items.
+// @group Concurrency
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Unlike New, the slice is copied: a borrowed slice could still be written
+// by the caller without holding the lock.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2, 3})
+//	sc.Push(4)
+//	collection.Dump(sc.Snapshot().Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	//   3 => 4 #int
+//	// ]
+func NewSync[T any](items []T) *SyncCollection[T] {
+	s := &SyncCollection[T]{}
+	s.c.items = New(items).Clone().items
+	return s
+}
+
+// Snapshot returns a consistent copy of the current items as a plain
+// Collection.
+// @group Concurrency
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// The copy does not share a backing array with the SyncCollection, so it can
+// be read, chained and mutated freely without holding any lock.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{3, 1, 2})
+//	snap := sc.Snapshot()
+//	sc.Push(4)
+//	collection.Dump(snap.Sort(func(a, b int) bool { return a < b }).Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func (s *SyncCollection[T]) Snapshot() *Collection[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Clone()
+}
+
+// Update runs fn with exclusive access to the underlying collection.
+// @group Concurrency
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Every operation inside fn is applied atomically with respect to other
+// goroutines. fn should use the in-place methods (Filter, Sort, Prepend,
+// Splice, ...); the collection must not be retained or used after fn returns,
+// and fn must not call back into s.
+//
+// Example: transaction
+//
+//	stock := collection.NewSync([]int{5, 0, 3, 0})
+//	stock.Update(func(c *collection.Collection[int]) {
+//		c.Filter(func(v int) bool { return v > 0 }).
+//			Sort(func(a, b int) bool { return a < b })
+//	})
+//	collection.Dump(stock.Snapshot().Items())
+//	// #[]int [
+//	//   0 => 3 #int
+//	//   1 => 5 #int
+//	// ]
+func (s *SyncCollection[T]) Update(fn func(c *Collection[T])) *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.c)
+	return s
+}
+
+// View runs fn with shared, read-only access to the underlying collection.
+// @group Concurrency
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Several readers may run View at once, and no writer runs until fn returns,
+// so fn observes a consistent state across multiple reads. fn must not mutate
+// the collection, retain it after returning, or call mutating methods on s.
+//
+// Example: consistent reads
+//
+//	sc := collection.NewSync([]int{4, 8, 15})
+//	sc.View(func(c *collection.Collection[int]) {
+//		first, _ := c.First()
+//		last, _ := c.Last()
+//		fmt.Println(c.Count(), first, last)
+//	})
+//	// 3 4 15
+func (s *SyncCollection[T]) View(fn func(c *Collection[T])) *SyncCollection[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(&s.c)
+	return s
+}
+
+// Count returns the number of items.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2, 3})
+//	fmt.Println(sc.Count())
+//	// 3
+func (s *SyncCollection[T]) Count() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Count()
+}
+
+// IsEmpty reports whether the collection has no items.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: zero value
+//
+//	var sc collection.SyncCollection[string]
+//	fmt.Println(sc.IsEmpty())
+//	// true
+func (s *SyncCollection[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.IsEmpty()
+}
+
+// At returns the item at index i and whether i was within bounds.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{10, 20})
+//	v, ok := sc.At(1)
+//	fmt.Println(v, ok)
+//	// 20 true
+func (s *SyncCollection[T]) At(i int) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.At(i)
+}
+
+// First returns the first item and whether the collection was non-empty.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{10, 20})
+//	v, ok := sc.First()
+//	fmt.Println(v, ok)
+//	// 10 true
+func (s *SyncCollection[T]) First() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.First()
+}
+
+// Last returns the last item and whether the collection was non-empty.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{10, 20})
+//	v, ok := sc.Last()
+//	fmt.Println(v, ok)
+//	// 20 true
+func (s *SyncCollection[T]) Last() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Last()
+}
+
+// FirstWhere returns the first item for which fn returns true.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// fn runs under the read lock and must not call mutating methods on s.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 4, 6})
+//	v, ok := sc.FirstWhere(func(v int) bool { return v%2 == 0 })
+//	fmt.Println(v, ok)
+//	// 4 true
+func (s *SyncCollection[T]) FirstWhere(fn func(T) bool) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.FirstWhere(fn)
+}
+
+// IndexWhere returns the index of the first item for which fn returns true.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// fn runs under the read lock and must not call mutating methods on s.
+//
+// Example: strings
+//
+//	sc := collection.NewSync([]string{"a", "b", "c"})
+//	i, ok := sc.IndexWhere(func(v string) bool { return v == "c" })
+//	fmt.Println(i, ok)
+//	// 2 true
+func (s *SyncCollection[T]) IndexWhere(fn func(T) bool) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.IndexWhere(fn)
+}
+
+// Any reports whether fn returns true for at least one item.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// fn runs under the read lock and must not call mutating methods on s.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 3, 4})
+//	fmt.Println(sc.Any(func(v int) bool { return v%2 == 0 }))
+//	// true
+func (s *SyncCollection[T]) Any(fn func(T) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Any(fn)
+}
+
+// All reports whether fn returns true for every item.
+// @group Concurrency
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// fn runs under the read lock and must not call mutating methods on s.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{2, 4})
+//	fmt.Println(sc.All(func(v int) bool { return v%2 == 0 }))
+//	// true
+func (s *SyncCollection[T]) All(fn func(T) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.All(fn)
+}
+
+// Each calls fn for every item under the read lock.
+// @group Concurrency
+// @behavior readonly
+// @chainable true
+// @terminal false
+//
+// Writers wait until iteration finishes, so keep fn short, and do not call
+// mutating methods on s from fn. For long-running work, iterate a Snapshot.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2, 3})
+//	total := 0
+//	sc.Each(func(v int) { total += v })
+//	fmt.Println(total)
+//	// 6
+func (s *SyncCollection[T]) Each(fn func(T)) *SyncCollection[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.c.Each(fn)
+	return s
+}
+
+// Push appends values to the end of the collection.
+// @group Concurrency
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: strings
+//
+//	sc := collection.NewSync([]string{"a"})
+//	sc.Push("b", "c")
+//	collection.Dump(sc.Snapshot().Items())
+//	// #[]string [
+//	//   0 => "a" #string
+//	//   1 => "b" #string
+//	//   2 => "c" #string
+//	// ]
+func (s *SyncCollection[T]) Push(values ...T) *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.items = append(s.c.items, values...)
+	return s
+}
+
+// Prepend adds values to the beginning of the collection.
+// @group Concurrency
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{3})
+//	sc.Prepend(1, 2)
+//	collection.Dump(sc.Snapshot().Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func (s *SyncCollection[T]) Prepend(values ...T) *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Prepend(values...)
+	return s
+}
+
+// Pop removes and returns the last item.
+// @group Concurrency
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2})
+//	v, ok := sc.Pop()
+//	fmt.Println(v, ok, sc.Count())
+//	// 2 true 1
+func (s *SyncCollection[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Pop()
+}
+
+// RemoveAt removes and returns the item at index i.
+// @group Concurrency
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2, 3})
+//	v, ok := sc.RemoveAt(0)
+//	fmt.Println(v, ok, sc.Count())
+//	// 1 true 2
+func (s *SyncCollection[T]) RemoveAt(i int) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.RemoveAt(i)
+}
+
+// RemoveWhere removes every item for which fn returns true.
+// @group Concurrency
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// fn runs under the write lock and must not call back into s.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2, 3, 4})
+//	sc.RemoveWhere(func(v int) bool { return v > 2 })
+//	collection.Dump(sc.Snapshot().Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	// ]
+func (s *SyncCollection[T]) RemoveWhere(fn func(T) bool) *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.RemoveWhere(fn)
+	return s
+}
+
+// Filter keeps only the items for which fn returns true.
+// @group Concurrency
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// fn runs under the write lock and must not call back into s.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2, 3, 4})
+//	sc.Filter(func(v int) bool { return v%2 == 0 })
+//	collection.Dump(sc.Snapshot().Items())
+//	// #[]int [
+//	//   0 => 2 #int
+//	//   1 => 4 #int
+//	// ]
+func (s *SyncCollection[T]) Filter(fn func(T) bool) *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Filter(fn)
+	return s
+}
+
+// Map replaces each item with the result of fn.
+// @group Concurrency
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// fn runs under the write lock and must not call back into s.
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2})
+//	sc.Map(func(v int) int { return v * 10 })
+//	collection.Dump(sc.Snapshot().Items())
+//	// #[]int [
+//	//   0 => 10 #int
+//	//   1 => 20 #int
+//	// ]
+func (s *SyncCollection[T]) Map(fn func(T) T) *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Map(fn)
+	return s
+}
+
+// Sort sorts the collection in place using less.
+// @group Concurrency
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{3, 1, 2})
+//	sc.Sort(func(a, b int) bool { return a < b })
+//	collection.Dump(sc.Snapshot().Items())
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 2 #int
+//	//   2 => 3 #int
+//	// ]
+func (s *SyncCollection[T]) Sort(less func(a, b T) bool) *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Sort(less)
+	return s
+}
+
+// Reverse reverses the order of the items in place.
+// @group Concurrency
+// @behavior mutable
+// @chainable true
+// @terminal false
+//
+// Example: integers
+//
+//	sc := collection.NewSync([]int{1, 2, 3})
+//	sc.Reverse()
+//	collection.Dump(sc.Snapshot().Items())
+//	// #[]int [
+//	//   0 => 3 #int
+//	//   1 => 2 #int
+//	//   2 => 1 #int
+//	// ]
+func (s *SyncCollection[T]) Reverse() *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Reverse()
+	return s
+}
@@ -0,0 +1,171 @@
+package collection
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestNewSync_CopiesInput(t *testing.T) {
+	items := []int{1, 2, 3}
+	sc := NewSync(items)
+
+	items[0] = 99
+
+	if v, _ := sc.First(); v != 1 {
+		t.Fatalf("expected NewSync to copy its input, got first %d", v)
+	}
+}
+
+func TestSyncCollection_ZeroValue(t *testing.T) {
+	var sc SyncCollection[string]
+
+	if !sc.IsEmpty() || sc.Count() != 0 {
+		t.Fatalf("expected empty zero value")
+	}
+	if _, ok := sc.Pop(); ok {
+		t.Fatalf("expected Pop on empty collection to report false")
+	}
+
+	sc.Push("a").Prepend("z")
+	if !reflect.DeepEqual(sc.Snapshot().Items(), []string{"z", "a"}) {
+		t.Fatalf("unexpected items: %v", sc.Snapshot().Items())
+	}
+}
+
+func TestSyncCollection_ReadOperations(t *testing.T) {
+	sc := NewSync([]int{5, 6, 7})
+	even := func(v int) bool { return v%2 == 0 }
+
+	if v, ok := sc.At(1); !ok || v != 6 {
+		t.Fatalf("At(1): got %v, %v", v, ok)
+	}
+	if v, ok := sc.Last(); !ok || v != 7 {
+		t.Fatalf("Last: got %v, %v", v, ok)
+	}
+	if v, ok := sc.FirstWhere(even); !ok || v != 6 {
+		t.Fatalf("FirstWhere: got %v, %v", v, ok)
+	}
+	if i, ok := sc.IndexWhere(even); !ok || i != 1 {
+		t.Fatalf("IndexWhere: got %v, %v", i, ok)
+	}
+	if !sc.Any(even) || sc.All(even) {
+		t.Fatalf("unexpected Any/All results")
+	}
+
+	var seen []int
+	sc.Each(func(v int) { seen = append(seen, v) })
+	if !reflect.DeepEqual(seen, []int{5, 6, 7}) {
+		t.Fatalf("Each: got %v", seen)
+	}
+}
+
+func TestSyncCollection_MutatingOperations(t *testing.T) {
+	sc := NewSync([]int{4, 1, 3, 2})
+
+	sc.Sort(func(a, b int) bool { return a < b }).
+		Reverse().
+		Map(func(v int) int { return v * 10 }).
+		Filter(func(v int) bool { return v != 30 })
+
+	if !reflect.DeepEqual(sc.Snapshot().Items(), []int{40, 20, 10}) {
+		t.Fatalf("unexpected items: %v", sc.Snapshot().Items())
+	}
+
+	if v, ok := sc.RemoveAt(1); !ok || v != 20 {
+		t.Fatalf("RemoveAt(1): got %v, %v", v, ok)
+	}
+	sc.RemoveWhere(func(v int) bool { return v > 20 })
+	if !reflect.DeepEqual(sc.Snapshot().Items(), []int{10}) {
+		t.Fatalf("unexpected items: %v", sc.Snapshot().Items())
+	}
+}
+
+func TestSyncCollection_SnapshotIsDetached(t *testing.T) {
+	sc := NewSync([]int{1, 2})
+
+	snap := sc.Snapshot()
+	snap.Items()[0] = 99
+	sc.Map(func(v int) int { return -v })
+
+	if !reflect.DeepEqual(snap.Items(), []int{99, 2}) {
+		t.Fatalf("snapshot changed after mutation: %v", snap.Items())
+	}
+	if v, _ := sc.First(); v != -1 {
+		t.Fatalf("snapshot write leaked into SyncCollection: %d", v)
+	}
+}
+
+func TestSyncCollection_UpdateAndView(t *testing.T) {
+	sc := NewSync([]int{3, 1, 2})
+
+	sc.Update(func(c *Collection[int]) {
+		c.Sort(func(a, b int) bool { return a < b }).Prepend(0)
+	})
+
+	var got []int
+	sc.View(func(c *Collection[int]) {
+		got = c.Clone().Items()
+	})
+
+	if !reflect.DeepEqual(got, []int{0, 1, 2, 3}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+}
+
+func TestSyncCollection_ConcurrentAccess(t *testing.T) {
+	var sc SyncCollection[int]
+	const writers, perWriter = 8, 200
+
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := range perWriter {
+				sc.Push(w*perWriter + i)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range perWriter {
+				sc.View(func(c *Collection[int]) {
+					sum := 0
+					c.Each(func(v int) { sum += v })
+					_ = sum
+				})
+				_ = sc.Snapshot().Count()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if sc.Count() != writers*perWriter {
+		t.Fatalf("expected %d items, got %d", writers*perWriter, sc.Count())
+	}
+}
+
+func TestSyncCollection_UpdateIsAtomic(t *testing.T) {
+	sc := NewSync([]int{0})
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sc.Update(func(c *Collection[int]) {
+				last, _ := c.Last()
+				c.InsertAt(c.Count(), last+1)
+			})
+		}()
+	}
+	wg.Wait()
+
+	// Each Update appends last+1; interleaving would produce duplicates.
+	items := sc.Snapshot().Items()
+	for i, v := range items {
+		if v != i {
+			t.Fatalf("expected a gap-free sequence, got %v", items)
+		}
+	}
+}
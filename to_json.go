@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 )
@@ -11,6 +12,7 @@ import (
 // @chainable false
 // @terminal true
 //
+// ToJSON produces the same output as MarshalJSON.
 // If marshalling succeeds, a JSON-encoded string and a nil error are returned.
 // If marshalling fails, the method unwraps any json.Marshal wrapping so that
 // user-defined MarshalJSON errors surface directly.
@@ -26,7 +28,7 @@ import (
 //	fmt.Println(out1)
 //	// ["a","b"]
 func (c *Collection[T]) ToJSON() (string, error) {
-	b, err := json.Marshal(c.items)
+	b, err := c.MarshalJSON()
 	if err != nil {
 		return "", errors.Unwrap(err)
 	}
@@ -58,9 +60,14 @@ func (c *Collection[T]) ToJSON() (string, error) {
 //	//  "b"
 //	// ]
 func (c *Collection[T]) ToPrettyJSON() (string, error) {
-	b, err := json.MarshalIndent(c.items, "", "  ")
+	b, err := c.MarshalJSON()
 	if err != nil {
 		return "", errors.Unwrap(err)
 	}
-	return string(b), nil
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, b, "", "  "); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
 }
@@ -0,0 +1,140 @@
+package collection
+
+// TopK returns a new collection with the k items that sort first under less,
+// in ranked order.
+// @group Ordering
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// This is equivalent to Clone().SortStable(less).Take(k) but runs in
+// O(n log k) using a bounded heap and never touches the source order.
+// Items that compare equal keep their original relative order.
+// If k <= 0, an empty collection is returned; if k exceeds the length,
+// every item is returned in sorted order.
+//
+// Example: integers - three largest
+//
+//	c := collection.New([]int{5, 1, 9, 3, 7})
+//	top := c.TopK(3, func(a, b int) bool { return a > b })
+//	collection.Dump(top.Items(), c.Items())
+//	// #[]int [
+//	//   0 => 9 #int
+//	//   1 => 7 #int
+//	//   2 => 5 #int
+//	// ]
+//	// #[]int [
+//	//   0 => 5 #int
+//	//   1 => 1 #int
+//	//   2 => 9 #int
+//	//   3 => 3 #int
+//	//   4 => 7 #int
+//	// ]
+func (c *Collection[T]) TopK(k int, less func(a, b T) bool) *Collection[T] {
+	return New(topK(c.items, k, lessToCmp(less)))
+}
+
+// TopKBy returns a new collection with the k items whose keys are largest,
+// highest first.
+// @group Ordering
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Items with equal keys keep their original relative order. The source
+// collection is not modified. Runs in O(n log k).
+//
+// This cannot be a method because methods can't introduce a new type parameter K.
+//
+// Example: structs - top devices by error count
+//
+//	type Device struct {
+//		Name   string
+//		Errors int
+//	}
+//
+//	devices := collection.New([]Device{
+//		{Name: "r1", Errors: 3},
+//		{Name: "r2", Errors: 15},
+//		{Name: "r3", Errors: 22},
+//		{Name: "r4", Errors: 8},
+//	})
+//
+//	top := collection.TopKBy(devices, 2, func(d Device) int { return d.Errors })
+//	collection.Dump(top.Items())
+//	// #[]main.Device [
+//	//   0 => #main.Device {
+//	//     +Name   => "r3" #string
+//	//     +Errors => 22 #int
+//	//   }
+//	//   1 => #main.Device {
+//	//     +Name   => "r2" #string
+//	//     +Errors => 15 #int
+//	//   }
+//	// ]
+func TopKBy[T any, K Number | ~string](c *Collection[T], k int, keyFn func(T) K) *Collection[T] {
+	return New(topK(c.items, k, ByDesc(keyFn).Compare))
+}
+
+// BottomKBy returns a new collection with the k items whose keys are
+// smallest, lowest first.
+// @group Ordering
+// @behavior immutable
+// @chainable true
+// @terminal false
+//
+// Items with equal keys keep their original relative order. The source
+// collection is not modified. Runs in O(n log k).
+//
+// This cannot be a method because methods can't introduce a new type parameter K.
+//
+// Example: strings - two shortest
+//
+//	words := collection.New([]string{"collection", "go", "forj", "io"})
+//	short := collection.BottomKBy(words, 2, func(s string) int { return len(s) })
+//	collection.Dump(short.Items())
+//	// #[]string [
+//	//   0 => "go" #string
+//	//   1 => "io" #string
+//	// ]
+func BottomKBy[T any, K Number | ~string](c *Collection[T], k int, keyFn func(T) K) *Collection[T] {
+	return New(topK(c.items, k, By(keyFn).Compare))
+}
+
+// NthElement returns the item that would be at index n if the collection
+// were sorted by less.
+// The second return value is false if n is out of range.
+// @group Ordering
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// NthElement uses quickselect on a scratch copy, running in expected O(n)
+// without sorting or mutating the collection.
+//
+// Example: integers - second smallest
+//
+//	c := collection.New([]int{40, 10, 30, 20})
+//	v, ok := c.NthElement(1, func(a, b int) bool { return a < b })
+//	collection.Dump(v, ok)
+//	// 20 #int
+//	// true #bool
+//
+// Example: out of range
+//
+//	v2, ok2 := c.NthElement(10, func(a, b int) bool { return a < b })
+//	collection.Dump(v2, ok2)
+//	// 0 #int
+//	// false #bool
+func (c *Collection[T]) NthElement(n int, less func(a, b T) bool) (T, bool) {
+	if n < 0 || n >= len(c.items) {
+		var zero T
+		return zero, false
+	}
+
+	cp := make([]T, len(c.items))
+	copy(cp, c.items)
+
+	quickselect(cp, n, lessToCmp(less))
+	return cp[n], true
+}
@@ -0,0 +1,130 @@
+package collection
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestTopK_RankedAndSourceUntouched(t *testing.T) {
+	c := New([]int{5, 1, 9, 3, 7})
+
+	top := c.TopK(3, func(a, b int) bool { return a > b })
+
+	if !reflect.DeepEqual(top.Items(), []int{9, 7, 5}) {
+		t.Fatalf("expected [9 7 5], got %v", top.Items())
+	}
+	if !reflect.DeepEqual(c.Items(), []int{5, 1, 9, 3, 7}) {
+		t.Fatalf("source was mutated: %v", c.Items())
+	}
+}
+
+func TestTopK_Bounds(t *testing.T) {
+	c := New([]int{3, 1, 2})
+	less := func(a, b int) bool { return a < b }
+
+	if got := c.TopK(0, less).Items(); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil result for k=0, got %#v", got)
+	}
+	if got := c.TopK(-1, less).Items(); len(got) != 0 {
+		t.Fatalf("expected empty result for k<0, got %v", got)
+	}
+	if got := c.TopK(10, less).Items(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("expected all items sorted, got %v", got)
+	}
+	if got := New([]int{}).TopK(2, less).Items(); len(got) != 0 {
+		t.Fatalf("expected empty result for empty input, got %v", got)
+	}
+}
+
+func TestTopK_MatchesStableSortTake(t *testing.T) {
+	type item struct{ key, id int }
+
+	r := rand.New(rand.NewSource(1))
+	items := make([]item, 500)
+	for i := range items {
+		items[i] = item{key: r.Intn(20), id: i}
+	}
+	less := func(a, b item) bool { return a.key > b.key }
+
+	for _, k := range []int{1, 5, 37, 499, 500} {
+		want := slices.Clone(items)
+		slices.SortStableFunc(want, lessToCmp(less))
+
+		got := New(items).TopK(k, less).Items()
+		if !reflect.DeepEqual(got, want[:k]) {
+			t.Fatalf("k=%d: TopK differs from stable sort + take", k)
+		}
+	}
+}
+
+func TestTopKBy_TiesKeepInputOrder(t *testing.T) {
+	type row struct {
+		Name  string
+		Score int
+	}
+	c := New([]row{{"a", 1}, {"b", 3}, {"c", 3}, {"d", 2}})
+
+	top := TopKBy(c, 2, func(r row) int { return r.Score })
+
+	if !reflect.DeepEqual(top.Items(), []row{{"b", 3}, {"c", 3}}) {
+		t.Fatalf("unexpected top rows: %v", top.Items())
+	}
+}
+
+func TestBottomKBy_Ascending(t *testing.T) {
+	c := New([]string{"collection", "go", "forj", "io"})
+
+	got := BottomKBy(c, 3, func(s string) int { return len(s) }).Items()
+
+	if !reflect.DeepEqual(got, []string{"go", "io", "forj"}) {
+		t.Fatalf("expected [go io forj], got %v", got)
+	}
+}
+
+func TestNthElement_MatchesSorted(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	items := make([]int, 301)
+	for i := range items {
+		items[i] = r.Intn(50)
+	}
+	sorted := slices.Clone(items)
+	slices.Sort(sorted)
+
+	c := New(items)
+	for n := range items {
+		v, ok := c.NthElement(n, func(a, b int) bool { return a < b })
+		if !ok || v != sorted[n] {
+			t.Fatalf("n=%d: expected %d, got %d (ok=%v)", n, sorted[n], v, ok)
+		}
+	}
+	if !reflect.DeepEqual(c.Items(), items) {
+		t.Fatalf("NthElement mutated the collection")
+	}
+}
+
+func TestNthElement_OutOfRange(t *testing.T) {
+	c := New([]int{1, 2})
+	less := func(a, b int) bool { return a < b }
+
+	if _, ok := c.NthElement(-1, less); ok {
+		t.Fatalf("expected ok=false for n<0")
+	}
+	if _, ok := c.NthElement(2, less); ok {
+		t.Fatalf("expected ok=false for n>=len")
+	}
+}
+
+func TestQuickselect_AllEqual(t *testing.T) {
+	s := make([]int, 1000)
+	for i := range s {
+		s[i] = 7
+	}
+
+	quickselect(s, 500, func(a, b int) int { return a - b })
+
+	if s[500] != 7 {
+		t.Fatalf("expected 7, got %d", s[500])
+	}
+}
@@ -0,0 +1,285 @@
+package collection
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TryMode selects how the Try* helpers react when a callback returns an error.
+type TryMode int
+
+const (
+	// FailFast stops at the first error and returns it as an *ItemError.
+	FailFast TryMode = iota
+	// CollectAll keeps going after errors, skips failing items, and returns
+	// every failure as an ItemErrors value.
+	CollectAll
+)
+
+// ItemError records a callback failure for a single item.
+//
+// It unwraps to the callback's error, so errors.Is and errors.As see
+// through it.
+type ItemError[T any] struct {
+	Index int
+	Item  T
+	Err   error
+}
+
+// Error implements the error interface.
+// @group Error Handling
+// @behavior readonly
+// @chainable false
+// @terminal true
+func (e *ItemError[T]) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the callback's error.
+// @group Error Handling
+// @behavior readonly
+// @chainable false
+// @terminal true
+func (e *ItemError[T]) Unwrap() error {
+	return e.Err
+}
+
+// ItemErrors aggregates every failure from a CollectAll run, in index order.
+//
+// It implements Unwrap() []error like errors.Join, so errors.Is and
+// errors.As search every recorded failure, and it can itself be passed to
+// errors.Join.
+type ItemErrors[T any] []*ItemError[T]
+
+// Error implements the error interface, one failure per line.
+// @group Error Handling
+// @behavior readonly
+// @chainable false
+// @terminal true
+func (es ItemErrors[T]) Error() string {
+	var b strings.Builder
+	for i, e := range es {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(e.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the recorded failures as a slice of errors.
+// @group Error Handling
+// @behavior readonly
+// @chainable false
+// @terminal true
+func (es ItemErrors[T]) Unwrap() []error {
+	out := make([]error, len(es))
+	for i, e := range es {
+		out[i] = e
+	}
+	return out
+}
+
+// err returns es as an error, or nil when nothing was recorded.
+func (es ItemErrors[T]) err() error {
+	if len(es) == 0 {
+		return nil
+	}
+	return es
+}
+
+// TryMapTo maps a Collection[T] to a Collection[R] using a fallible fn.
+// @group Error Handling
+// @behavior immutable
+// @chainable false
+// @terminal true
+//
+// With FailFast, mapping stops at the first error; the returned collection
+// holds the results produced before it and the error is an *ItemError[T].
+// With CollectAll, failing items are skipped, the returned collection holds
+// every successful result in order, and the error is an ItemErrors[T].
+//
+// This cannot be a method because methods can't introduce a new type parameter R.
+//
+// Example: integers - fail fast
+//
+//	raw := collection.New([]string{"1", "x", "3"})
+//	nums, err := collection.TryMapTo(raw, collection.FailFast, strconv.Atoi)
+//	collection.Dump(nums.Items())
+//	fmt.Println(err)
+//	// #[]int [
+//	//   0 => 1 #int
+//	// ]
+//	// item 1: strconv.Atoi: parsing "x": invalid syntax
+//
+// Example: integers - collect all
+//
+//	raw2 := collection.New([]string{"1", "x", "3", "y"})
+//	nums2, err2 := collection.TryMapTo(raw2, collection.CollectAll, strconv.Atoi)
+//	collection.Dump(nums2.Items())
+//	fmt.Println(err2)
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 3 #int
+//	// ]
+//	// item 1: strconv.Atoi: parsing "x": invalid syntax
+//	// item 3: strconv.Atoi: parsing "y": invalid syntax
+func TryMapTo[T any, R any](c *Collection[T], mode TryMode, fn func(T) (R, error)) (*Collection[R], error) {
+	items := c.items
+	out := make([]R, 0, len(items))
+	var errs ItemErrors[T]
+
+	for i, v := range items {
+		r, err := fn(v)
+		if err != nil {
+			ie := &ItemError[T]{Index: i, Item: v, Err: err}
+			if mode == FailFast {
+				return New(out), ie
+			}
+			errs = append(errs, ie)
+			continue
+		}
+		out = append(out, r)
+	}
+
+	return New(out), errs.err()
+}
+
+// TryFilter keeps only the elements for which a fallible fn returns true.
+// @group Error Handling
+// @behavior mutable
+// @chainable false
+// @terminal true
+//
+// Like Filter, this compacts the collection in place and returns the same
+// instance. Predicates are evaluated before any item moves, so with FailFast
+// an error leaves the collection unchanged and is returned as an *ItemError[T].
+// With CollectAll, failing items are dropped and the error is an ItemErrors[T].
+//
+// Example: integers - collect all
+//
+//	c := collection.New([]int{1, -2, 3, 4})
+//	_, err := c.TryFilter(collection.CollectAll, func(v int) (bool, error) {
+//		if v < 0 {
+//			return false, fmt.Errorf("negative value %d", v)
+//		}
+//		return v%2 == 1, nil
+//	})
+//	collection.Dump(c.Items())
+//	fmt.Println(err)
+//	// #[]int [
+//	//   0 => 1 #int
+//	//   1 => 3 #int
+//	// ]
+//	// item 1: negative value -2
+func (c *Collection[T]) TryFilter(mode TryMode, fn func(T) (bool, error)) (*Collection[T], error) {
+	items := c.items
+	keep := make([]bool, len(items))
+	var errs ItemErrors[T]
+
+	for i, v := range items {
+		ok, err := fn(v)
+		if err != nil {
+			ie := &ItemError[T]{Index: i, Item: v, Err: err}
+			if mode == FailFast {
+				return c, ie
+			}
+			errs = append(errs, ie)
+			continue
+		}
+		keep[i] = ok
+	}
+
+	j := 0
+	for i := range items {
+		if keep[i] {
+			items[j] = items[i]
+			j++
+		}
+	}
+
+	clear(items[j:])
+	c.items = items[:j]
+	return c, errs.err()
+}
+
+// TryEach runs a fallible fn for every item in the collection.
+// @group Error Handling
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// With FailFast, iteration stops at the first error, returned as an
+// *ItemError[T]. With CollectAll, every item is visited and all failures
+// are returned as an ItemErrors[T].
+//
+// Example: strings - validation
+//
+//	names := collection.New([]string{"alice", "", "carol"})
+//	err := names.TryEach(collection.FailFast, func(s string) error {
+//		if s == "" {
+//			return errors.New("empty name")
+//		}
+//		return nil
+//	})
+//	fmt.Println(err)
+//	// item 1: empty name
+func (c *Collection[T]) TryEach(mode TryMode, fn func(T) error) error {
+	var errs ItemErrors[T]
+
+	for i, v := range c.items {
+		if err := fn(v); err != nil {
+			ie := &ItemError[T]{Index: i, Item: v, Err: err}
+			if mode == FailFast {
+				return ie
+			}
+			errs = append(errs, ie)
+		}
+	}
+
+	return errs.err()
+}
+
+// TryReduce collapses the collection into a single value using a fallible fn.
+// @group Error Handling
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Accumulation is left to right, like Reduce. With FailFast, the accumulator
+// reached before the failing item is returned alongside an *ItemError[T].
+// With CollectAll, failing items leave the accumulator untouched and all
+// failures are returned as an ItemErrors[T].
+//
+// Example: integers - overflow guard
+//
+//	c := collection.New([]int{40, 50, 30})
+//	total, err := c.TryReduce(collection.FailFast, 0, func(acc, v int) (int, error) {
+//		if acc+v > 100 {
+//			return acc, errors.New("budget exceeded")
+//		}
+//		return acc + v, nil
+//	})
+//	collection.Dump(total)
+//	fmt.Println(err)
+//	// 90 #int
+//	// item 2: budget exceeded
+func (c *Collection[T]) TryReduce(mode TryMode, initial T, fn func(T, T) (T, error)) (T, error) {
+	acc := initial
+	var errs ItemErrors[T]
+
+	for i, v := range c.items {
+		next, err := fn(acc, v)
+		if err != nil {
+			ie := &ItemError[T]{Index: i, Item: v, Err: err}
+			if mode == FailFast {
+				return acc, ie
+			}
+			errs = append(errs, ie)
+			continue
+		}
+		acc = next
+	}
+
+	return acc, errs.err()
+}
@@ -0,0 +1,236 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+var errTryTest = errors.New("try test")
+
+func TestTryMapTo_SuccessReturnsNilError(t *testing.T) {
+	out, err := TryMapTo(New([]string{"1", "2"}), FailFast, strconv.Atoi)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out.Items(), []int{1, 2}) {
+		t.Fatalf("unexpected items: %v", out.Items())
+	}
+}
+
+func TestTryMapTo_FailFastStopsAtFirstError(t *testing.T) {
+	calls := 0
+	out, err := TryMapTo(New([]int{1, 2, 3}), FailFast, func(v int) (int, error) {
+		calls++
+		if v == 2 {
+			return 0, errTryTest
+		}
+		return v * 10, nil
+	})
+
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+	if !reflect.DeepEqual(out.Items(), []int{10}) {
+		t.Fatalf("expected partial result [10], got %v", out.Items())
+	}
+
+	var ie *ItemError[int]
+	if !errors.As(err, &ie) {
+		t.Fatalf("expected *ItemError[int], got %T", err)
+	}
+	if ie.Index != 1 || ie.Item != 2 {
+		t.Fatalf("unexpected item error: %+v", ie)
+	}
+	if !errors.Is(err, errTryTest) {
+		t.Fatalf("expected errors.Is to match the callback error")
+	}
+}
+
+func TestTryMapTo_CollectAllRecordsEveryFailure(t *testing.T) {
+	out, err := TryMapTo(New([]string{"a", "1", "b", "2"}), CollectAll, strconv.Atoi)
+
+	if !reflect.DeepEqual(out.Items(), []int{1, 2}) {
+		t.Fatalf("expected successful results [1 2], got %v", out.Items())
+	}
+
+	var errs ItemErrors[string]
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ItemErrors[string], got %T", err)
+	}
+	if len(errs) != 2 || errs[0].Index != 0 || errs[1].Index != 2 {
+		t.Fatalf("unexpected failures: %v", errs)
+	}
+	if errs[1].Item != "b" {
+		t.Fatalf(`expected failing item "b", got %q`, errs[1].Item)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("expected errors.Is to see through aggregated errors")
+	}
+}
+
+func TestTryMapTo_CollectAllNoFailuresIsNil(t *testing.T) {
+	_, err := TryMapTo(New([]string{"1"}), CollectAll, strconv.Atoi)
+
+	if err != nil {
+		t.Fatalf("expected untyped nil error, got %#v", err)
+	}
+}
+
+func TestItemErrors_AsFindsIndividualItemError(t *testing.T) {
+	_, err := TryMapTo(New([]string{"x"}), CollectAll, strconv.Atoi)
+
+	var ie *ItemError[string]
+	if !errors.As(err, &ie) || ie.Index != 0 {
+		t.Fatalf("expected errors.As to find the first *ItemError, got %v", err)
+	}
+}
+
+func TestItemErrors_WorksWithErrorsJoin(t *testing.T) {
+	_, err := TryMapTo(New([]string{"x"}), CollectAll, strconv.Atoi)
+
+	joined := errors.Join(errTryTest, err)
+
+	if !errors.Is(joined, strconv.ErrSyntax) || !errors.Is(joined, errTryTest) {
+		t.Fatalf("expected joined error to match both causes")
+	}
+}
+
+func TestItemErrors_ErrorMessage(t *testing.T) {
+	errs := ItemErrors[int]{
+		{Index: 0, Item: 1, Err: errors.New("a")},
+		{Index: 3, Item: 4, Err: errors.New("b")},
+	}
+
+	if got := errs.Error(); got != "item 0: a\nitem 3: b" {
+		t.Fatalf("unexpected message %q", got)
+	}
+}
+
+func TestTryFilter_FailFastLeavesCollectionUnchanged(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	c := New(items)
+
+	out, err := c.TryFilter(FailFast, func(v int) (bool, error) {
+		if v == 3 {
+			return false, errTryTest
+		}
+		return v%2 == 0, nil
+	})
+
+	if out != c {
+		t.Fatalf("TryFilter should return the same collection")
+	}
+	if !errors.Is(err, errTryTest) {
+		t.Fatalf("expected errTryTest, got %v", err)
+	}
+	if !reflect.DeepEqual(c.Items(), []int{1, 2, 3, 4}) {
+		t.Fatalf("expected collection unchanged, got %v", c.Items())
+	}
+}
+
+func TestTryFilter_CollectAllDropsFailingItems(t *testing.T) {
+	c := New([]int{1, 2, 3, 4})
+
+	_, err := c.TryFilter(CollectAll, func(v int) (bool, error) {
+		if v == 2 {
+			return true, errTryTest
+		}
+		return true, nil
+	})
+
+	if !reflect.DeepEqual(c.Items(), []int{1, 3, 4}) {
+		t.Fatalf("expected [1 3 4], got %v", c.Items())
+	}
+
+	var errs ItemErrors[int]
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Index != 1 {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTryFilter_Success(t *testing.T) {
+	c := New([]int{1, 2, 3, 4})
+
+	_, err := c.TryFilter(FailFast, func(v int) (bool, error) {
+		return v > 2, nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(c.Items(), []int{3, 4}) {
+		t.Fatalf("expected [3 4], got %v", c.Items())
+	}
+}
+
+func TestTryEach_FailFastStops(t *testing.T) {
+	var seen []int
+	err := New([]int{1, 2, 3}).TryEach(FailFast, func(v int) error {
+		seen = append(seen, v)
+		if v == 2 {
+			return errTryTest
+		}
+		return nil
+	})
+
+	if !reflect.DeepEqual(seen, []int{1, 2}) {
+		t.Fatalf("expected iteration to stop at 2, saw %v", seen)
+	}
+	if !errors.Is(err, errTryTest) {
+		t.Fatalf("expected errTryTest, got %v", err)
+	}
+}
+
+func TestTryEach_CollectAllVisitsEveryItem(t *testing.T) {
+	var seen []int
+	err := New([]int{1, 2, 3}).TryEach(CollectAll, func(v int) error {
+		seen = append(seen, v)
+		return errTryTest
+	})
+
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Fatalf("expected every item visited, saw %v", seen)
+	}
+
+	var errs ItemErrors[int]
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Fatalf("expected 3 recorded failures, got %v", err)
+	}
+}
+
+func TestTryReduce_FailFastReturnsAccumulatorSoFar(t *testing.T) {
+	acc, err := New([]int{1, 2, 3}).TryReduce(FailFast, 0, func(acc, v int) (int, error) {
+		if v == 3 {
+			return 0, errTryTest
+		}
+		return acc + v, nil
+	})
+
+	if acc != 3 {
+		t.Fatalf("expected accumulator 3, got %d", acc)
+	}
+	if !errors.Is(err, errTryTest) {
+		t.Fatalf("expected errTryTest, got %v", err)
+	}
+}
+
+func TestTryReduce_CollectAllSkipsFailures(t *testing.T) {
+	acc, err := New([]int{1, 2, 3, 4}).TryReduce(CollectAll, 0, func(acc, v int) (int, error) {
+		if v%2 == 0 {
+			return -1, errTryTest
+		}
+		return acc + v, nil
+	})
+
+	if acc != 4 {
+		t.Fatalf("expected accumulator 4, got %d", acc)
+	}
+
+	var errs ItemErrors[int]
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("expected 2 failures, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package collection
+
+// Variance returns the population variance of the collection.
+// Returns (0, false) if the collection is empty.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// The population variance divides by n. Use SampleVariance when the values
+// are a sample of a larger population.
+//
+// Example: integers
+//
+//	c := collection.NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	v, ok := c.Variance()
+//	collection.Dump(v, ok)
+//	// 4.000000 #float64
+//	// true #bool
+func (c *NumericCollection[T]) Variance() (float64, bool) {
+	m := welford(c.items)
+	if m.n == 0 {
+		return 0, false
+	}
+	return m.m2 / float64(m.n), true
+}
+
+// SampleVariance returns the sample variance of the collection, using
+// Bessel's correction (dividing by n-1).
+// Returns (0, false) if the collection has fewer than two items.
+// @group Aggregation
+// @behavior readonly
+// @chainable false
+// @terminal true
+//
+// Example: integers
+//
+//	c := collection.NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	v, ok := c.SampleVariance()
+//	collection.Dump(v, ok)
+//	// 4.571429 #float64
+//	// true #bool
+func (c *NumericCollection[T]) SampleVariance() (float64, bool) {
+	m := welford(c.items)
+	if m.n < 2 {
+		return 0, false
+	}
+	return m.m2 / float64(m.n-1), true
+}
+
+// moments holds statistics gathered in one pass over a numeric slice.
+type moments[T Number] struct {
+	n        int
+	min, max T
+	mean     float64
+	m2       float64 // sum of squared deviations from the mean
+}
+
+// welford computes the count, min, max, mean and sum of squared deviations
+// of items in a single numerically stable pass (Welford's algorithm).
+// min and max are zero when items is empty.
+func welford[T Number](items []T) moments[T] {
+	var m moments[T]
+	if len(items) == 0 {
+		return m
+	}
+
+	m.min, m.max = items[0], items[0]
+	for i, v := range items {
+		if v < m.min {
+			m.min = v
+		}
+		if v > m.max {
+			m.max = v
+		}
+
+		x := float64(v)
+		delta := x - m.mean
+		m.mean += delta / float64(i+1)
+		m.m2 += delta * (x - m.mean)
+	}
+	m.n = len(items)
+	return m
+}
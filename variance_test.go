@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVariance_Population(t *testing.T) {
+	v, ok := NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9}).Variance()
+
+	if !ok || v != 4 {
+		t.Fatalf("expected 4, got %v (ok=%v)", v, ok)
+	}
+}
+
+func TestVariance_Empty(t *testing.T) {
+	if _, ok := NewNumeric([]int{}).Variance(); ok {
+		t.Fatalf("expected ok=false for empty collection")
+	}
+}
+
+func TestVariance_SingleItem(t *testing.T) {
+	v, ok := NewNumeric([]float64{3.5}).Variance()
+
+	if !ok || v != 0 {
+		t.Fatalf("expected 0, got %v (ok=%v)", v, ok)
+	}
+}
+
+func TestSampleVariance_Bessel(t *testing.T) {
+	v, ok := NewNumeric([]int{2, 4, 4, 4, 5, 5, 7, 9}).SampleVariance()
+
+	if !ok || math.Abs(v-32.0/7.0) > 1e-12 {
+		t.Fatalf("expected 32/7, got %v (ok=%v)", v, ok)
+	}
+}
+
+func TestSampleVariance_NeedsTwoItems(t *testing.T) {
+	if _, ok := NewNumeric([]int{1}).SampleVariance(); ok {
+		t.Fatalf("expected ok=false for a single item")
+	}
+}
+
+func TestVariance_LargeOffsetIsStable(t *testing.T) {
+	// A naive sum-of-squares approach loses all precision here.
+	v, _ := NewNumeric([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}).Variance()
+
+	if math.Abs(v-22.5) > 1e-6 {
+		t.Fatalf("expected 22.5, got %v", v)
+	}
+}
+
+func TestWelford_Moments(t *testing.T) {
+	m := welford([]int{4, -2, 9, 1})
+
+	if m.n != 4 || m.min != -2 || m.max != 9 || m.mean != 3 {
+		t.Fatalf("unexpected moments: %+v", m)
+	}
+	if m.m2 != 66 {
+		t.Fatalf("expected sum of squared deviations 66, got %v", m.m2)
+	}
+
+	if empty := welford([]float64{}); empty.n != 0 || empty.min != 0 || empty.max != 0 {
+		t.Fatalf("expected zero moments for empty input, got %+v", empty)
+	}
+}